@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// asnRange is one block of addresses announced by an autonomous system.
+type asnRange struct {
+	start netip.Addr
+	end   netip.Addr
+	asn   uint32
+	org   string
+}
+
+// asnDB maps addresses to the autonomous system announcing them. It is
+// loaded once from a local file so lookups never leave the machine.
+type asnDB struct {
+	ranges []asnRange
+}
+
+// loadASNDB reads an IP to ASN file. Two layouts are understood, comma or
+// tab separated and optionally gzipped:
+//
+//	range_start, range_end, asn[, ...], organisation   (iptoasn.com)
+//	network, asn, organisation                         (GeoLite2 ASN CSV)
+//
+// Header rows and blocks announced by AS0 are skipped.
+func loadASNDB(path string) (*asnDB, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var src io.Reader = bufio.NewReader(f)
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(src)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		defer gz.Close()
+		src = gz
+	}
+
+	br := bufio.NewReader(src)
+	first, err := br.Peek(4096)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	r := csv.NewReader(br)
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.ReuseRecord = true
+	if line, _, _ := strings.Cut(string(first), "\n"); strings.Contains(line, "\t") {
+		r.Comma = '\t'
+	}
+
+	db := &asnDB{}
+	orgs := map[string]string{}
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		rng, ok := parseASNRecord(rec)
+		if !ok || rng.asn == 0 {
+			continue
+		}
+
+		// organisation names repeat for every block an AS announces.
+		if org, ok := orgs[rng.org]; ok {
+			rng.org = org
+		} else {
+			orgs[rng.org] = rng.org
+		}
+		db.ranges = append(db.ranges, rng)
+	}
+
+	if len(db.ranges) == 0 {
+		return nil, fmt.Errorf("%s: no address ranges found", path)
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return db.ranges[i].start.Less(db.ranges[j].start)
+	})
+
+	return db, nil
+}
+
+func parseASNRecord(rec []string) (asnRange, bool) {
+	if len(rec) < 2 {
+		return asnRange{}, false
+	}
+	for i := range rec {
+		rec[i] = strings.TrimSpace(rec[i])
+	}
+
+	var rng asnRange
+	var rest []string
+	if prefix, err := netip.ParsePrefix(rec[0]); err == nil {
+		prefix = prefix.Masked()
+		rng.start = prefix.Addr()
+		rng.end = lastAddr(prefix)
+		rest = rec[1:]
+	} else {
+		start, err := netip.ParseAddr(rec[0])
+		if err != nil || len(rec) < 3 {
+			return asnRange{}, false
+		}
+		end, err := netip.ParseAddr(rec[1])
+		if err != nil || start.Is4() != end.Is4() {
+			return asnRange{}, false
+		}
+		rng.start, rng.end = start.Unmap(), end.Unmap()
+		rest = rec[2:]
+	}
+
+	asn, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(rest[0]), "AS"), 10, 32)
+	if err != nil {
+		return asnRange{}, false
+	}
+	rng.asn = uint32(asn)
+	if len(rest) > 1 {
+		rng.org = rest[len(rest)-1]
+	}
+
+	return rng, true
+}
+
+// lastAddr is the highest address in prefix.
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	b := prefix.Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(b)*8; bit++ {
+		b[bit/8] |= 0x80 >> (bit % 8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+// lookup returns the AS number and organisation announcing ip, or zero when
+// the address is not in the database.
+func (db *asnDB) lookup(ip string) (uint32, string) {
+	addr, ok := parseHostAddr(ip)
+	if !ok {
+		return 0, ""
+	}
+
+	// the last range starting at or before addr is the only candidate.
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return addr.Less(db.ranges[i].start)
+	}) - 1
+	if i < 0 {
+		return 0, ""
+	}
+
+	rng := db.ranges[i]
+	if rng.end.Less(addr) || rng.start.Is4() != addr.Is4() {
+		return 0, ""
+	}
+	return rng.asn, rng.org
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseASNRecord(t *testing.T) {
+	tests := []struct {
+		name       string
+		rec        []string
+		start, end string
+		asn        uint32
+		org        string
+		ok         bool
+	}{
+		{
+			name:  "iptoasn range",
+			rec:   []string{"1.0.0.0", "1.0.0.255", "13335", "US", "CLOUDFLARENET"},
+			start: "1.0.0.0", end: "1.0.0.255", asn: 13335, org: "CLOUDFLARENET", ok: true,
+		},
+		{
+			name:  "iptoasn IPv6 range",
+			rec:   []string{"2001:db8::", "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff", "64500", "ZZ", "Example"},
+			start: "2001:db8::", end: "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff", asn: 64500, org: "Example", ok: true,
+		},
+		{
+			name:  "iptoasn IPv4-mapped range",
+			rec:   []string{"::ffff:1.0.0.0", "::ffff:1.0.0.255", "13335", "US", "CLOUDFLARENET"},
+			start: "1.0.0.0", end: "1.0.0.255", asn: 13335, org: "CLOUDFLARENET", ok: true,
+		},
+		{
+			name:  "GeoLite2 network",
+			rec:   []string{"1.0.0.0/24", "13335", "Cloudflare, Inc."},
+			start: "1.0.0.0", end: "1.0.0.255", asn: 13335, org: "Cloudflare, Inc.", ok: true,
+		},
+		{
+			name:  "GeoLite2 IPv6 network",
+			rec:   []string{"2001:db8::/32", "64500", "Example"},
+			start: "2001:db8::", end: "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff", asn: 64500, org: "Example", ok: true,
+		},
+		{
+			name:  "unmasked network",
+			rec:   []string{"1.0.0.7/24", "13335", "Cloudflare"},
+			start: "1.0.0.0", end: "1.0.0.255", asn: 13335, org: "Cloudflare", ok: true,
+		},
+		{
+			name:  "AS prefix and spaces",
+			rec:   []string{" 1.0.0.0/24 ", " as13335 ", " Cloudflare "},
+			start: "1.0.0.0", end: "1.0.0.255", asn: 13335, org: "Cloudflare", ok: true,
+		},
+		{
+			name:  "no organisation",
+			rec:   []string{"1.0.0.0/24", "13335"},
+			start: "1.0.0.0", end: "1.0.0.255", asn: 13335, ok: true,
+		},
+		{name: "GeoLite2 header", rec: []string{"network", "autonomous_system_number", "autonomous_system_organization"}},
+		{name: "too short", rec: []string{"1.0.0.0/24"}},
+		{name: "range without asn", rec: []string{"1.0.0.0", "1.0.0.255"}},
+		{name: "mixed families", rec: []string{"1.0.0.0", "2001:db8::", "13335", "US", "X"}},
+		{name: "bad end", rec: []string{"1.0.0.0", "1.0.0", "13335", "US", "X"}},
+		{name: "bad asn", rec: []string{"1.0.0.0/24", "thirteen", "X"}},
+		{name: "asn too large", rec: []string{"1.0.0.0/24", "4294967296", "X"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rng, ok := parseASNRecord(tt.rec)
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, want %v (%+v)", ok, tt.ok, rng)
+			}
+			if !ok {
+				return
+			}
+			if rng.start.String() != tt.start || rng.end.String() != tt.end {
+				t.Errorf("range %s - %s, want %s - %s", rng.start, rng.end, tt.start, tt.end)
+			}
+			if rng.asn != tt.asn || rng.org != tt.org {
+				t.Errorf("AS%d %q, want AS%d %q", rng.asn, rng.org, tt.asn, tt.org)
+			}
+		})
+	}
+}
+
+func TestLastAddr(t *testing.T) {
+	tests := map[string]string{
+		"10.0.0.0/8":       "10.255.255.255",
+		"192.0.2.0/24":     "192.0.2.255",
+		"192.0.2.128/25":   "192.0.2.255",
+		"192.0.2.7/32":     "192.0.2.7",
+		"0.0.0.0/0":        "255.255.255.255",
+		"198.51.100.0/22":  "198.51.103.255",
+		"2001:db8::/32":    "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff",
+		"2001:db8:1::/48":  "2001:db8:1:ffff:ffff:ffff:ffff:ffff",
+		"2001:db8::/127":   "2001:db8::1",
+		"2001:db8::1/128":  "2001:db8::1",
+		"2001:db8:ab::/44": "2001:db8:af:ffff:ffff:ffff:ffff:ffff",
+	}
+	for prefix, want := range tests {
+		if got := lastAddr(netip.MustParsePrefix(prefix)); got.String() != want {
+			t.Errorf("lastAddr(%s) = %s, want %s", prefix, got, want)
+		}
+	}
+}
+
+func TestLoadASNDB(t *testing.T) {
+	const tsv = "1.0.0.0\t1.0.0.255\t13335\tUS\tCLOUDFLARENET\n" +
+		"1.0.4.0\t1.0.7.255\t38803\tAU\tWPL-AS-AP\n" +
+		"2.0.0.0\t2.0.0.255\t0\tNone\tNot routed\n" +
+		"2001:db8::\t2001:db8:ffff:ffff:ffff:ffff:ffff:ffff\t64500\tZZ\tExample\n"
+	const csv = "network,autonomous_system_number,autonomous_system_organization\n" +
+		"1.0.0.0/24,13335,\"Cloudflare, Inc.\"\n" +
+		"1.0.4.0/22,38803,\"Wirefreebroadband Pty Ltd\"\n" +
+		"2.0.0.0/24,0,\n" +
+		"2001:db8::/32,64500,Example\n"
+
+	gzipped := func(s string) string {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		zw.Write([]byte(s))
+		zw.Close()
+		return buf.String()
+	}
+
+	tests := []struct {
+		file, data string
+		org        string
+	}{
+		{"ip2asn-v4.tsv", tsv, "CLOUDFLARENET"},
+		{"ip2asn-v4.tsv.gz", gzipped(tsv), "CLOUDFLARENET"},
+		{"GeoLite2-ASN.csv", csv, "Cloudflare, Inc."},
+		{"GeoLite2-ASN.csv.gz", gzipped(csv), "Cloudflare, Inc."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.data), 0o666); err != nil {
+				t.Fatal(err)
+			}
+
+			db, err := loadASNDB(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the AS0 block is not loaded.
+			if len(db.ranges) != 3 {
+				t.Fatalf("loaded %d ranges, want 3", len(db.ranges))
+			}
+
+			for ip, want := range map[string]uint32{
+				"1.0.0.1":      13335,
+				"1.0.5.9":      38803,
+				"1.0.3.1":      0,
+				"2.0.0.1":      0,
+				"2001:db8::17": 64500,
+			} {
+				if got, _ := db.lookup(ip); got != want {
+					t.Errorf("lookup(%s) = AS%d, want AS%d", ip, got, want)
+				}
+			}
+			if _, org := db.lookup("1.0.0.1"); org != tt.org {
+				t.Errorf("organisation %q, want %q", org, tt.org)
+			}
+		})
+	}
+
+	empty := filepath.Join(t.TempDir(), "empty.csv")
+	if err := os.WriteFile(empty, []byte("network,autonomous_system_number\n"), 0o666); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadASNDB(empty); err == nil {
+		t.Fatal("file without ranges accepted")
+	}
+	notGzip := filepath.Join(t.TempDir(), "plain.csv.gz")
+	if err := os.WriteFile(notGzip, []byte(csv), 0o666); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadASNDB(notGzip); err == nil {
+		t.Fatal("plain file named .gz accepted")
+	}
+}
+
+func TestASNLookupFamilies(t *testing.T) {
+	rng := func(start, end string, asn uint32) asnRange {
+		return asnRange{start: netip.MustParseAddr(start), end: netip.MustParseAddr(end), asn: asn}
+	}
+	// sorted as loadASNDB sorts them: every IPv4 range before IPv6 ones.
+	db := &asnDB{ranges: []asnRange{
+		rng("1.0.0.0", "1.0.0.255", 1),
+		rng("255.255.255.0", "255.255.255.255", 2),
+		rng("::", "::ff", 3),
+		rng("2001:db8::", "2001:db8::ffff", 4),
+	}}
+
+	tests := map[string]uint32{
+		"0.255.255.255":       0,
+		"1.0.0.0":             1,
+		"1.0.0.255":           1,
+		"1.0.1.0":             0,
+		"255.255.255.255":     2,
+		"[::ffff:1.0.0.9]:80": 1,
+		// IPv4 addresses never fall in the IPv6 range below ::ff and
+		// IPv6 ones never in the last IPv4 range.
+		"::1":               3,
+		"::100":             0,
+		"2001:db8::1":       4,
+		"2001:db8::1:0":     0,
+		"ffff::1":           0,
+		"not an address":    0,
+		"[2001:db8::2]:443": 4,
+	}
+	for ip, want := range tests {
+		if got, _ := db.lookup(ip); got != want {
+			t.Errorf("lookup(%s) = AS%d, want AS%d", ip, got, want)
+		}
+	}
+}
+
+func TestStatsNetworkAggregation(t *testing.T) {
+	events, err := OpenEventStore(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer events.Close()
+
+	const ua = "Googlebot/2.1 (+http://www.google.com/bot.html)"
+	now := time.Now()
+	for _, hit := range []struct {
+		ip  string
+		asn uint32
+	}{
+		{"198.51.100.1", 64500},
+		{"198.51.100.200", 64500},
+		{"198.51.101.1", 64500},
+		{"2001:db8:cafe:1::1", 64501},
+		{"2001:db8:cafe:ffff::2", 64501},
+		{"2001:db8:beef::1", 0},
+	} {
+		ev := Event{Time: now, ClientIP: hit.ip, ASN: hit.asn, UserAgent: ua, Crawler: true}
+		if err := events.Append(ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	report, err := buildStatsReport(events, statsRange{From: today, To: today}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantNetworks := map[string]int{
+		"198.51.100.0/24":    2,
+		"198.51.101.0/24":    1,
+		"2001:db8:cafe::/48": 2,
+		"2001:db8:beef::/48": 1,
+	}
+	if fmt.Sprint(report.Networks) != fmt.Sprint(wantNetworks) {
+		t.Errorf("Networks = %v, want %v", report.Networks, wantNetworks)
+	}
+	if len(report.IPs) != 6 {
+		t.Errorf("%d IPs, want 6", len(report.IPs))
+	}
+	if len(report.ASNs) != 2 || report.ASNs["AS64500"].Total != 3 || report.ASNs["AS64501"].Total != 2 {
+		t.Errorf("ASNs = %v, want AS64500 with 3 hits and AS64501 with 2", report.ASNs)
+	}
+
+	fs := report.Families["Googlebot"]
+	if fs == nil {
+		t.Fatalf("no Googlebot family in %v", report.Families)
+	}
+	if fs.IPs != 6 || fs.Networks != 4 || fs.ASNs != 2 {
+		t.Errorf("family counts %d IPs, %d networks, %d ASNs, want 6, 4 and 2", fs.IPs, fs.Networks, fs.ASNs)
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+)
+
+// canarySyllables are glued together into names and words that should not
+// exist anywhere else, so finding one in the wild points back to the page
+// it was served on.
+var canarySyllables = []string{
+	"ka", "lo", "ven", "dri", "mar", "tho", "qui", "sel", "bra", "nor",
+	"vel", "zan", "ori", "tes", "pha", "lun", "gor", "wyn", "ast", "cel",
+	"dro", "fen", "hal", "ixa", "jor", "kel", "mov", "nix", "opa", "pel",
+	"rus", "sav", "tor", "ulm", "vex", "wal", "yra", "zed", "bel", "cor",
+}
+
+// canary is the set of unique strings planted in one served page. Every
+// session gets its own for the same URL, so they identify the client and
+// session the page went to rather than the page.
+type canary struct {
+	Name   string
+	Email  string
+	Phrase string
+}
+
+// newCanary makes the canary for page in session, with an email address at
+// domain. It is derived from the secret, so a page fetched again in the same
+// session is identical while other sessions get a canary of their own.
+func newCanary(secret, session, page, domain string) canary {
+	rng := canaryRand(secret, session, page)
+	first, last := canaryWord(rng, 3), canaryWord(rng, 3)
+
+	if host, _, err := net.SplitHostPort(domain); err == nil {
+		domain = host
+	}
+
+	return canary{
+		Name:   capitalise(first) + " " + capitalise(last),
+		Email:  fmt.Sprintf("%s.%s%d@%s", first, last, rng.Intn(100), domain),
+		Phrase: capitalise(canaryWord(rng, 3)) + "ian " + canaryWord(rng, 3),
+	}
+}
+
+func canaryWord(rng *rand.Rand, syllables int) string {
+	var b strings.Builder
+	for i := 0; i < syllables; i++ {
+		b.WriteString(canarySyllables[rng.Intn(len(canarySyllables))])
+	}
+	return b.String()
+}
+
+// Strings are the canary values recorded on the hit's event.
+func (c canary) Strings() []string {
+	return []string{c.Name, c.Email, c.Phrase}
+}
+
+// errNoCanary is returned by lookupCanary when nothing matched.
+var errNoCanary = errors.New("no canary found")
+
+// lookupCanary scans the event log for canaries contained in text, case
+// insensitively, and writes one tab separated line per page they were
+// served on: time, canary, family, client IP, session, page and user agent.
+func lookupCanary(events *EventStore, text string, out io.Writer) error {
+	text = strings.ToLower(strings.Join(strings.Fields(text), " "))
+	if text == "" {
+		return errors.New("nothing to look up")
+	}
+
+	found := false
+	err := events.Scan(func(ev Event) error {
+		for _, c := range ev.Canaries {
+			if !strings.Contains(text, strings.ToLower(c)) {
+				continue
+			}
+
+			found = true
+			fmt.Fprintf(out, "%s\t%s\t%s\t%s\t%s\t%s\t%q\n",
+				ev.Time.Format(time.RFC3339),
+				c,
+				crawlerFamily(ev.UserAgent).Name,
+				eventClientIP(ev),
+				ev.Session,
+				requestPage(ev.Host, ev.Path),
+				ev.UserAgent,
+			)
+			break
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errNoCanary
+	}
+	return nil
+}
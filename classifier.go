@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// hiddenPathPrefix is where the invisible, nofollow link on every page
+	// points. People never see it and polite crawlers do not follow it.
+	hiddenPathPrefix = "/private/"
+	// trackingCookie is set on every page to see whether it comes back.
+	trackingCookie = "gl_session"
+
+	behaviorIdle      = 30 * time.Minute
+	behaviorRateSpan  = time.Minute
+	behaviorRateLimit = 30
+	behaviorDepth     = 6
+	behaviorThreshold = 4
+
+	// behaviorClients bounds how many clients are remembered at once.
+	behaviorClients = 100000
+)
+
+// Verdict is the behavioural judgement of a client.
+type Verdict struct {
+	Bot     bool     `json:"bot"`
+	Score   int      `json:"score"`
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// clientBehavior is what we have seen a client do so far.
+type clientBehavior struct {
+	last time.Time
+	// recent are the times of the requests in the last minute, at most
+	// behaviorRateLimit+1 of them.
+	recent []time.Time
+	pages  int
+	// depth is the deepest point of the link graph the client reached.
+	depth   int
+	images  int
+	cookies int
+	hidden  bool
+}
+
+// classifier scores clients on how they behave rather than on what their
+// user agent claims, so crawlers posing as browsers are still caught.
+type classifier struct {
+	mu        sync.Mutex
+	clients   map[string]*clientBehavior
+	lastSweep time.Time
+}
+
+func newClassifier() *classifier {
+	return &classifier{clients: map[string]*clientBehavior{}}
+}
+
+// observe records the request from the client identified by key for a page
+// depth levels into the link graph and returns the client's verdict
+// including this request.
+func (c *classifier) observe(key string, r *http.Request, kind string, depth int, now time.Time) Verdict {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if now.Sub(c.lastSweep) > behaviorIdle {
+		c.sweep(now)
+	}
+
+	b, ok := c.clients[key]
+	if !ok {
+		if len(c.clients) >= behaviorClients {
+			c.sweep(now)
+		}
+		if len(c.clients) >= behaviorClients {
+			evictOldest(c.clients, len(c.clients)/4, func(b *clientBehavior) time.Time { return b.last })
+		}
+		b = &clientBehavior{}
+		c.clients[key] = b
+	}
+	b.last = now
+
+	cutoff := now.Add(-behaviorRateSpan)
+	kept := b.recent[:0]
+	for _, t := range b.recent {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	// only going over the limit matters, so no more than one request past
+	// it is remembered however fast the client is.
+	if len(kept) > behaviorRateLimit {
+		kept = kept[:copy(kept, kept[len(kept)-behaviorRateLimit:])]
+	}
+	b.recent = append(kept, now)
+
+	switch kind {
+	case kindImage:
+		b.images++
+	case kindPage, kindEndless:
+		b.pages++
+		b.depth = max(b.depth, depth)
+		if _, err := r.Cookie(trackingCookie); err == nil {
+			b.cookies++
+		}
+	}
+
+	if strings.HasPrefix(r.URL.Path, hiddenPathPrefix) {
+		b.hidden = true
+	}
+
+	var v Verdict
+	flag := func(score int, reason string) {
+		v.Score += score
+		v.Reasons = append(v.Reasons, reason)
+	}
+
+	if len(b.recent) > behaviorRateLimit {
+		flag(3, fmt.Sprintf("more than %d requests in the last minute", behaviorRateLimit))
+	}
+	if b.depth >= behaviorDepth {
+		flag(2, fmt.Sprintf("followed links %d levels deep", b.depth))
+	}
+	if r.Header.Get("Accept-Language") == "" {
+		flag(1, "no Accept-Language header")
+	}
+	if b.pages > 1 && b.cookies == 0 {
+		flag(2, "never returned the session cookie")
+	}
+	if b.pages >= 3 && b.images == 0 {
+		flag(1, "never fetched an image")
+	}
+	if b.hidden {
+		flag(5, "followed a hidden link")
+	}
+
+	v.Bot = v.Score >= behaviorThreshold
+	return v
+}
+
+// sweep forgets clients that have been idle for a while. The caller holds
+// c.mu.
+func (c *classifier) sweep(now time.Time) {
+	for key, b := range c.clients {
+		if now.Sub(b.last) > behaviorIdle {
+			delete(c.clients, key)
+		}
+	}
+	c.lastSweep = now
+}
+
+// oldestKeys returns the keys of m, least recently seen first.
+func oldestKeys[V any](m map[string]V, last func(V) time.Time) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		return last(m[a]).Compare(last(m[b]))
+	})
+	return keys
+}
+
+// evictOldest makes room in a per client map that reached its cap by
+// deleting the n least recently seen entries. Evicting a batch at a time
+// keeps the sort off the path of most requests.
+func evictOldest[V any](m map[string]V, n int, last func(V) time.Time) {
+	for _, k := range oldestKeys(m, last)[:n] {
+		delete(m, k)
+	}
+}
+
+// hiddenLink is the invisible link placed on every page.
+func hiddenLink(rng *rand.Rand) link {
+	name := strings.ToLower(names[rng.Intn(len(names))])
+	return link{URL: hiddenPathPrefix + name + ".html", Title: name}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClassifierClientLimit(t *testing.T) {
+	c := newClassifier()
+	r := httptest.NewRequest("GET", "/", nil)
+
+	start := time.Now()
+	for i := 0; i < behaviorClients+1000; i++ {
+		// all within the idle time, so the sweep frees nothing.
+		c.observe(fmt.Sprintf("client-%d", i), r, kindPage, 0, start.Add(time.Duration(i)*time.Millisecond))
+	}
+
+	if len(c.clients) > behaviorClients {
+		t.Fatalf("remembering %d clients, cap is %d", len(c.clients), behaviorClients)
+	}
+	if _, ok := c.clients["client-0"]; ok {
+		t.Fatal("oldest client was not evicted")
+	}
+	if _, ok := c.clients[fmt.Sprintf("client-%d", behaviorClients+999)]; !ok {
+		t.Fatal("newest client was evicted")
+	}
+}
+
+func TestClassifierHiddenLink(t *testing.T) {
+	c := newClassifier()
+	now := time.Now()
+
+	v := c.observe("a", httptest.NewRequest("GET", hiddenPathPrefix+"x.html", nil), kindPage, 1, now)
+	if !v.Bot {
+		t.Fatalf("following the hidden link gave %+v, want a bot verdict", v)
+	}
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("Accept-Language", "en")
+	if v := c.observe("b", r, kindPage, 1, now); v.Bot {
+		t.Fatalf("a single page view gave %+v, want no bot verdict", v)
+	}
+}
+
+func TestClassifierRecentLimit(t *testing.T) {
+	c := newClassifier()
+	r := httptest.NewRequest("GET", "/", nil)
+
+	now := time.Now()
+	var v Verdict
+	for i := 0; i < 10*behaviorRateLimit; i++ {
+		v = c.observe("a", r, kindImage, 0, now.Add(time.Duration(i)*time.Millisecond))
+	}
+
+	if n := len(c.clients["a"].recent); n > behaviorRateLimit+1 {
+		t.Fatalf("remembering %d recent requests, cap is %d", n, behaviorRateLimit+1)
+	}
+	if v.Score < 3 {
+		t.Fatalf("a flood of requests gave %+v, want the rate flagged", v)
+	}
+
+	// the flood is forgotten a minute later.
+	if v := c.observe("a", r, kindImage, 0, now.Add(2*behaviorRateSpan)); v.Score >= 3 {
+		t.Fatalf("a request after a quiet minute gave %+v", v)
+	}
+}
+
+func TestClassifierDepth(t *testing.T) {
+	c := newClassifier()
+	r := httptest.NewRequest("GET", "/", nil)
+	now := time.Now()
+
+	// many pages near the top of the graph are a reader, not a crawler.
+	for i := 0; i < 50; i++ {
+		v := c.observe("a", r, kindPage, 2, now.Add(time.Duration(i)*time.Minute))
+		if strings.Contains(strings.Join(v.Reasons, ","), "levels deep") {
+			t.Fatalf("shallow pages flagged for depth: %+v", v)
+		}
+	}
+
+	v := c.observe("b", r, kindPage, behaviorDepth, now)
+	if !strings.Contains(strings.Join(v.Reasons, ","), "levels deep") {
+		t.Fatalf("a page %d levels deep gave %+v, want depth flagged", behaviorDepth, v)
+	}
+	// images do not count towards depth.
+	if v := c.observe("c", r, kindImage, 20, now); strings.Contains(strings.Join(v.Reasons, ","), "levels deep") {
+		t.Fatalf("an image flagged for depth: %+v", v)
+	}
+}
+
+func TestClassifierSignals(t *testing.T) {
+	type request struct {
+		kind         string
+		cookie, lang bool
+	}
+	page := request{kind: kindPage, cookie: true, lang: true}
+	image := request{kind: kindImage, lang: true}
+	repeat := func(r request, n int) []request {
+		rs := make([]request, n)
+		for i := range rs {
+			rs[i] = r
+		}
+		return rs
+	}
+
+	const (
+		rate    = "requests in the last minute"
+		cookie  = "never returned the session cookie"
+		images  = "never fetched an image"
+		lang    = "no Accept-Language header"
+		nothing = ""
+	)
+
+	tests := []struct {
+		name     string
+		requests []request
+		every    time.Duration
+		reason   string
+		absent   string
+		bot      bool
+	}{
+		{name: "browser", requests: []request{page, image, page, image, page}, every: 10 * time.Second, absent: rate},
+		{name: "over the rate limit", requests: repeat(image, behaviorRateLimit+1), every: time.Second, reason: rate},
+		{name: "at the rate limit", requests: repeat(image, behaviorRateLimit), every: time.Second, absent: rate},
+		{name: "fast but spread out", requests: repeat(image, 3*behaviorRateLimit), every: 2 * time.Second, absent: rate},
+		{name: "cookie never returned", requests: repeat(request{kind: kindPage, lang: true}, 2), every: time.Second, reason: cookie},
+		{name: "first page has no cookie yet", requests: []request{{kind: kindPage, lang: true}}, absent: cookie},
+		{name: "cookie returned", requests: []request{{kind: kindPage, lang: true}, page}, every: time.Second, absent: cookie},
+		{name: "no images", requests: repeat(page, 3), every: time.Second, reason: images},
+		{name: "an image", requests: append(repeat(page, 3), image), every: time.Second, absent: images},
+		{name: "too few pages to judge images", requests: repeat(page, 2), every: time.Second, absent: images},
+		{name: "no Accept-Language", requests: []request{{kind: kindPage}}, reason: lang},
+		{name: "Accept-Language", requests: []request{page}, absent: lang},
+		{
+			name:     "headless scraper",
+			requests: repeat(request{kind: kindPage}, 3),
+			every:    time.Second,
+			reason:   cookie,
+			bot:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClassifier()
+			now := time.Now()
+
+			var v Verdict
+			for i, req := range tt.requests {
+				r := httptest.NewRequest("GET", "/p/one.html", nil)
+				if req.cookie {
+					r.AddCookie(&http.Cookie{Name: trackingCookie, Value: "1"})
+				}
+				if req.lang {
+					r.Header.Set("Accept-Language", "en")
+				}
+				v = c.observe("client", r, req.kind, 2, now.Add(time.Duration(i)*tt.every))
+			}
+
+			reasons := strings.Join(v.Reasons, "; ")
+			if tt.reason != nothing && !strings.Contains(reasons, tt.reason) {
+				t.Errorf("reasons %q, want %q", reasons, tt.reason)
+			}
+			if tt.absent != nothing && strings.Contains(reasons, tt.absent) {
+				t.Errorf("reasons %q, want no %q", reasons, tt.absent)
+			}
+			if v.Bot != tt.bot {
+				t.Errorf("verdict %+v, want Bot %v", v, tt.bot)
+			}
+		})
+	}
+}
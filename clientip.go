@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// clientIPHeaders are the forwarding headers a trusted proxy can be said to
+// set.
+var clientIPHeaders = []string{"Forwarded", "X-Forwarded-For", "X-Real-IP"}
+
+// clientIPResolver works out the address of the client behind any trusted
+// reverse proxies. The one forwarding header the proxies set is only
+// believed when the hop that added it is in one of the trusted prefixes,
+// otherwise any client could claim any address. Other forwarding headers
+// are ignored, a proxy passes through whatever the client sent in them.
+type clientIPResolver struct {
+	trusted []netip.Prefix
+	header  string
+}
+
+func newClientIPResolver(cidrs []string, header string) (*clientIPResolver, error) {
+	h, err := clientIPHeader(header)
+	if err != nil {
+		return nil, err
+	}
+	trusted, err := parseTrustedProxies(cidrs)
+	if err != nil {
+		return nil, err
+	}
+	return &clientIPResolver{trusted: trusted, header: h}, nil
+}
+
+// clientIPHeader returns the forwarding header named, case insensitively.
+func clientIPHeader(name string) (string, error) {
+	for _, h := range clientIPHeaders {
+		if strings.EqualFold(h, name) {
+			return h, nil
+		}
+	}
+	return "", fmt.Errorf("unknown header %q, want one of %s", name, strings.Join(clientIPHeaders, ", "))
+}
+
+func parseTrustedProxies(cidrs []string) ([]netip.Prefix, error) {
+	var trusted []netip.Prefix
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			// a bare address trusts just that address.
+			addr, aerr := netip.ParseAddr(cidr)
+			if aerr != nil {
+				return nil, fmt.Errorf("trusted proxy %q: %w", cidr, err)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		trusted = append(trusted, prefix.Masked())
+	}
+	return trusted, nil
+}
+
+func (c *clientIPResolver) isTrusted(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, p := range c.trusted {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the client address for r. When the connected peer is a
+// trusted proxy the configured forwarding header is consulted. Chains are
+// walked from the right, skipping trusted proxies, and the first untrusted
+// address is the client.
+func (c *clientIPResolver) clientIP(r *http.Request) string {
+	peer, ok := parseHostAddr(r.RemoteAddr)
+	if !ok {
+		return r.RemoteAddr
+	}
+	if !c.isTrusted(peer) {
+		return peer.String()
+	}
+
+	values := r.Header.Values(c.header)
+	if len(values) == 0 {
+		return peer.String()
+	}
+
+	switch c.header {
+	case "Forwarded":
+		return c.walk(peer, forwardedFor(values)).String()
+	case "X-Forwarded-For":
+		var hops []string
+		for _, v := range values {
+			hops = append(hops, strings.Split(v, ",")...)
+		}
+		return c.walk(peer, hops).String()
+	default:
+		// X-Real-IP is a single address set by the closest proxy.
+		if addr, ok := parseHostAddr(strings.TrimSpace(values[len(values)-1])); ok {
+			return addr.String()
+		}
+		return peer.String()
+	}
+}
+
+// walk goes through hops from the closest to the furthest and returns the
+// first hop that is not a trusted proxy. An unparsable hop ends the walk at
+// the last address we could vouch for.
+func (c *clientIPResolver) walk(peer netip.Addr, hops []string) netip.Addr {
+	client := peer
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, ok := parseHostAddr(strings.TrimSpace(hops[i]))
+		if !ok {
+			return client
+		}
+		client = addr
+		if !c.isTrusted(addr) {
+			return client
+		}
+	}
+	return client
+}
+
+// forwardedFor extracts the for= parameters of RFC 7239 Forwarded headers,
+// in order.
+func forwardedFor(values []string) []string {
+	var hops []string
+	for _, v := range values {
+		for _, element := range strings.Split(v, ",") {
+			found := false
+			for _, pair := range strings.Split(element, ";") {
+				k, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+				if ok && strings.EqualFold(k, "for") {
+					hops = append(hops, strings.Trim(val, `"`))
+					found = true
+				}
+			}
+			if !found {
+				// keep the element so an element without for= is
+				// still a hop we cannot vouch for.
+				hops = append(hops, "")
+			}
+		}
+	}
+	return hops
+}
+
+// parseHostAddr parses an address that may carry a port and may be wrapped
+// in brackets, as found in RemoteAddr and forwarding headers.
+func parseHostAddr(s string) (netip.Addr, bool) {
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		s = host
+	}
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap().WithZone(""), true
+}
+
+// clientNetwork is the /24 of an IPv4 address or the /48 of an IPv6 one,
+// the block a single host or small network is usually given. Invalid
+// addresses are returned unchanged.
+func clientNetwork(ip string) string {
+	addr, ok := parseHostAddr(ip)
+	if !ok {
+		return ip
+	}
+
+	bits := 48
+	if addr.Is4() {
+		bits = 24
+	}
+	prefix, _ := addr.Prefix(bits)
+	return prefix.String()
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"cmp"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	trusted := []string{"10.0.0.0/8", "127.0.0.1", "fd00::/8"}
+
+	tests := []struct {
+		name   string
+		remote string
+		// header is the one the proxies set, X-Forwarded-For when empty.
+		header  string
+		headers map[string][]string
+		want    string
+	}{
+		{
+			name:   "no headers",
+			remote: "203.0.113.7:4711",
+			want:   "203.0.113.7",
+		},
+		{
+			name:    "untrusted peer sending X-Forwarded-For",
+			remote:  "203.0.113.7:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"198.51.100.1"}},
+			want:    "203.0.113.7",
+		},
+		{
+			name:    "untrusted peer sending Forwarded",
+			remote:  "203.0.113.7:4711",
+			header:  "Forwarded",
+			headers: map[string][]string{"Forwarded": {"for=198.51.100.1"}},
+			want:    "203.0.113.7",
+		},
+		{
+			name:    "untrusted peer sending X-Real-IP",
+			remote:  "203.0.113.7:4711",
+			header:  "X-Real-IP",
+			headers: map[string][]string{"X-Real-IP": {"198.51.100.1"}},
+			want:    "203.0.113.7",
+		},
+		{
+			name:    "untrusted peer claiming a trusted address",
+			remote:  "203.0.113.7:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"10.0.0.1"}},
+			want:    "203.0.113.7",
+		},
+		{
+			name:    "trusted peer",
+			remote:  "10.0.0.2:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"198.51.100.1"}},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "forged left-most hop",
+			remote:  "10.0.0.2:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"1.2.3.4, 198.51.100.1"}},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "forged trusted hop from the client",
+			remote:  "10.0.0.2:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"10.9.9.9, 198.51.100.1"}},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "several trusted hops",
+			remote:  "127.0.0.1:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"1.2.3.4, 198.51.100.1, 10.0.0.3, 10.0.0.2"}},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "several header lines",
+			remote:  "127.0.0.1:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"1.2.3.4, 198.51.100.1", "10.0.0.2"}},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "only trusted hops",
+			remote:  "127.0.0.1:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"10.0.0.3, 10.0.0.2"}},
+			want:    "10.0.0.3",
+		},
+		{
+			name:    "garbage hop",
+			remote:  "10.0.0.2:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"198.51.100.1, not-an-ip"}},
+			want:    "10.0.0.2",
+		},
+		{
+			name:   "Forwarded and X-Real-IP passed through from the client",
+			remote: "10.0.0.2:4711",
+			headers: map[string][]string{
+				"Forwarded":       {"for=1.2.3.4;proto=https"},
+				"X-Real-IP":       {"1.2.3.5"},
+				"X-Forwarded-For": {"198.51.100.2"},
+			},
+			want: "198.51.100.2",
+		},
+		{
+			name:    "only a Forwarded from the client",
+			remote:  "10.0.0.2:4711",
+			headers: map[string][]string{"Forwarded": {"for=1.2.3.4"}},
+			want:    "10.0.0.2",
+		},
+		{
+			name:    "X-Forwarded-For when Forwarded is set by the proxies",
+			remote:  "10.0.0.2:4711",
+			header:  "Forwarded",
+			headers: map[string][]string{"X-Forwarded-For": {"1.2.3.4"}},
+			want:    "10.0.0.2",
+		},
+		{
+			name:   "Forwarded set by the proxies",
+			remote: "10.0.0.2:4711",
+			header: "forwarded",
+			headers: map[string][]string{
+				"Forwarded":       {"for=198.51.100.1;proto=https"},
+				"X-Forwarded-For": {"1.2.3.4"},
+			},
+			want: "198.51.100.1",
+		},
+		{
+			name:    "Forwarded chain",
+			remote:  "10.0.0.2:4711",
+			header:  "Forwarded",
+			headers: map[string][]string{"Forwarded": {`for=1.2.3.4, for=198.51.100.1;by=10.0.0.3, for=10.0.0.3`}},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "Forwarded for=unknown",
+			remote:  "10.0.0.2:4711",
+			header:  "Forwarded",
+			headers: map[string][]string{"Forwarded": {"for=unknown"}},
+			want:    "10.0.0.2",
+		},
+		{
+			name:    "Forwarded obfuscated identifier",
+			remote:  "10.0.0.2:4711",
+			header:  "Forwarded",
+			headers: map[string][]string{"Forwarded": {`for="_hidden", for=10.0.0.3`}},
+			want:    "10.0.0.3",
+		},
+		{
+			name:    "Forwarded element without for",
+			remote:  "10.0.0.2:4711",
+			header:  "Forwarded",
+			headers: map[string][]string{"Forwarded": {"for=198.51.100.1, proto=https"}},
+			want:    "10.0.0.2",
+		},
+		{
+			name:    "Forwarded bracketed IPv6 with port",
+			remote:  "10.0.0.2:4711",
+			header:  "Forwarded",
+			headers: map[string][]string{"Forwarded": {`For="[2001:db8:cafe::17]:4711"`}},
+			want:    "2001:db8:cafe::17",
+		},
+		{
+			name:    "X-Forwarded-For bracketed IPv6 with port",
+			remote:  "10.0.0.2:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"[2001:db8::1]:8080"}},
+			want:    "2001:db8::1",
+		},
+		{
+			name:    "X-Forwarded-For IPv4 with port",
+			remote:  "10.0.0.2:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"198.51.100.1:8080"}},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "IPv6 peer",
+			remote:  "[fd00::1]:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"2001:db8::1"}},
+			want:    "2001:db8::1",
+		},
+		{
+			name:    "IPv4-mapped peer is trusted",
+			remote:  "[::ffff:10.0.0.2]:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"198.51.100.1"}},
+			want:    "198.51.100.1",
+		},
+		{
+			name:   "IPv4-mapped untrusted peer",
+			remote: "[::ffff:203.0.113.7]:4711",
+			want:   "203.0.113.7",
+		},
+		{
+			name:    "IPv4-mapped hop",
+			remote:  "10.0.0.2:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"::ffff:198.51.100.1"}},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "IPv4-mapped trusted hop",
+			remote:  "10.0.0.2:4711",
+			headers: map[string][]string{"X-Forwarded-For": {"198.51.100.1, ::ffff:10.0.0.3"}},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "X-Real-IP from a trusted peer",
+			remote:  "127.0.0.1:4711",
+			header:  "X-Real-IP",
+			headers: map[string][]string{"X-Real-IP": {"198.51.100.1"}},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "invalid X-Real-IP",
+			remote:  "127.0.0.1:4711",
+			header:  "X-Real-IP",
+			headers: map[string][]string{"X-Real-IP": {"nope"}},
+			want:    "127.0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver, err := newClientIPResolver(trusted, cmp.Or(tt.header, "X-Forwarded-For"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.remote
+			for k, vs := range tt.headers {
+				for _, v := range vs {
+					r.Header.Add(k, v)
+				}
+			}
+
+			if got := resolver.clientIP(r); got != tt.want {
+				t.Fatalf("clientIP = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewClientIPResolver(t *testing.T) {
+	if _, err := newClientIPResolver([]string{"10.0.0.0/33"}, "X-Forwarded-For"); err == nil {
+		t.Fatal("invalid prefix accepted")
+	}
+	if _, err := newClientIPResolver([]string{"proxy.local"}, "X-Forwarded-For"); err == nil {
+		t.Fatal("host name accepted")
+	}
+	if _, err := newClientIPResolver([]string{"", " 10.0.0.1 ", "::1"}, "x-real-ip"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newClientIPResolver(nil, "X-Client-IP"); err == nil {
+		t.Fatal("unknown header accepted")
+	}
+}
+
+func TestClientNetwork(t *testing.T) {
+	tests := map[string]string{
+		"198.51.100.17":        "198.51.100.0/24",
+		"2001:db8:cafe:1::17":  "2001:db8:cafe::/48",
+		"::ffff:198.51.100.17": "198.51.100.0/24",
+		"nonsense":             "nonsense",
+	}
+	for ip, want := range tests {
+		if got := clientNetwork(ip); got != want {
+			t.Errorf("clientNetwork(%s) = %s, want %s", ip, got, want)
+		}
+	}
+}
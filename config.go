@@ -0,0 +1,408 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the runtime configuration. Values are taken from, in increasing
+// order of precedence, the defaults, the YAML config file, environment
+// variables and command line flags.
+type Config struct {
+	// ListenAddr is the address the HTTP server binds to.
+	ListenAddr string `yaml:"listen_addr"`
+	// Domain is the base domain generated subdomain links are built on.
+	Domain string `yaml:"domain"`
+	// LogDir is where the daily stats CSV files are written and served from.
+	LogDir string `yaml:"log_dir"`
+	// EventDir holds the event log segments.
+	EventDir string `yaml:"event_dir"`
+	// EventSegmentSize is the size in bytes an event log segment grows to
+	// before a new one is started.
+	EventSegmentSize int64 `yaml:"event_segment_size"`
+	// FlushInterval is how often recorded stats are written to LogDir.
+	FlushInterval time.Duration `yaml:"flush_interval"`
+	// ShutdownTimeout bounds how long in flight requests get to finish on
+	// SIGTERM or SIGINT.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// SessionIdle is how long a client can go without a request before its
+	// next hit starts a new session.
+	SessionIdle time.Duration `yaml:"session_idle"`
+	// LinkCount is the number of generated links on every page.
+	LinkCount int `yaml:"link_count"`
+	// LinkMode is where generated links point: "subdomain" for generated
+	// subdomains of Domain, "path" for deep paths on the requested host or
+	// "both".
+	LinkMode string `yaml:"link_mode"`
+	// TemplateDir holds page layouts, .html files, that replace the built
+	// in ones of the same name or add to them.
+	TemplateDir string `yaml:"template_dir"`
+	// Templates limits the layouts hosts are given to those named. Empty
+	// uses them all.
+	Templates []string `yaml:"templates"`
+	// Tarpit configures drip feeding pages to clients.
+	Tarpit TarpitConfig `yaml:"tarpit"`
+	// Endless configures the endless page route.
+	Endless EndlessConfig `yaml:"endless"`
+	// Sitemap configures the generated sitemaps.
+	Sitemap SitemapConfig `yaml:"sitemap"`
+	// Feed configures the generated RSS and Atom feeds.
+	Feed FeedConfig `yaml:"feed"`
+	// Robots configures the generated robots.txt.
+	Robots RobotsConfig `yaml:"robots"`
+	// Verify configures DNS verification of search engine crawlers.
+	Verify VerifyConfig `yaml:"verify"`
+	// TrustedProxies are the CIDRs of reverse proxies whose forwarding
+	// headers are believed when working out the client address.
+	TrustedProxies []string `yaml:"trusted_proxies"`
+	// ClientIPHeader is the forwarding header the trusted proxies set:
+	// X-Forwarded-For, Forwarded or X-Real-IP. The others are ignored.
+	ClientIPHeader string `yaml:"client_ip_header"`
+	// ASNDB is an IP to ASN CSV or TSV file used to tag hits with the
+	// autonomous system of the client. Empty disables the lookup.
+	ASNDB string `yaml:"asn_db"`
+	// Secret is mixed into the seed of every generated page so the content
+	// cannot be predicted from the URL alone.
+	Secret string `yaml:"secret"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		ListenAddr:       "0.0.0.0:8070",
+		Domain:           "localhost:8070",
+		LogDir:           "./logs/gridlock",
+		EventDir:         "./data/events",
+		EventSegmentSize: defaultSegmentSize,
+		FlushInterval:    10 * time.Minute,
+		ShutdownTimeout:  30 * time.Second,
+		SessionIdle:      30 * time.Minute,
+		LinkCount:        7,
+		LinkMode:         linkModeSubdomain,
+		TrustedProxies:   []string{"127.0.0.1/32", "::1/128"},
+		ClientIPHeader:   "X-Forwarded-For",
+		Tarpit: TarpitConfig{
+			ChunkSize:     64,
+			MinDelay:      500 * time.Millisecond,
+			MaxDelay:      2 * time.Second,
+			MaxConns:      512,
+			MaxConnsPerIP: 4,
+		},
+		Endless: EndlessConfig{
+			Path:        "/stream/",
+			MaxBytes:    64 << 20,
+			MaxDuration: 30 * time.Minute,
+			Delay:       time.Second,
+		},
+		Sitemap: SitemapConfig{
+			URLs:     1000,
+			PerIndex: 50,
+		},
+		Feed: FeedConfig{
+			Items: 20,
+		},
+		Robots: RobotsConfig{
+			Default: RobotsPolicy{Disallow: []string{hiddenPathPrefix}},
+		},
+		Verify: VerifyConfig{
+			Timeout:  2 * time.Second,
+			CacheTTL: 24 * time.Hour,
+		},
+	}
+}
+
+// configEnv maps environment variables to the flag that sets the same value.
+var configEnv = map[string]string{
+	"LISTEN_ADDR":             "listen",
+	"DOMAIN":                  "domain",
+	"LOG_FILE_DIR":            "log-dir",
+	"EVENT_DIR":               "event-dir",
+	"EVENT_SEGMENT_SIZE":      "event-segment-size",
+	"FLUSH_INTERVAL":          "flush-interval",
+	"SHUTDOWN_TIMEOUT":        "shutdown-timeout",
+	"SESSION_IDLE":            "session-idle",
+	"LINK_COUNT":              "link-count",
+	"LINK_MODE":               "link-mode",
+	"SECRET":                  "secret",
+	"TRUSTED_PROXIES":         "trusted-proxies",
+	"CLIENT_IP_HEADER":        "client-ip-header",
+	"ASN_DB":                  "asn-db",
+	"TEMPLATE_DIR":            "template-dir",
+	"TEMPLATES":               "templates",
+	"TARPIT":                  "tarpit",
+	"TARPIT_CHUNK_SIZE":       "tarpit-chunk-size",
+	"TARPIT_MIN_DELAY":        "tarpit-min-delay",
+	"TARPIT_MAX_DELAY":        "tarpit-max-delay",
+	"TARPIT_MAX_CONNS":        "tarpit-max-conns",
+	"TARPIT_MAX_CONNS_PER_IP": "tarpit-max-conns-per-ip",
+	"ENDLESS":                 "endless",
+	"ENDLESS_PATH":            "endless-path",
+	"ENDLESS_MAX_BYTES":       "endless-max-bytes",
+	"ENDLESS_MAX_DURATION":    "endless-max-duration",
+	"ENDLESS_DELAY":           "endless-delay",
+	"SITEMAP":                 "sitemap",
+	"SITEMAP_URLS":            "sitemap-urls",
+	"SITEMAP_PER_INDEX":       "sitemap-per-index",
+	"FEED":                    "feed",
+	"FEED_ITEMS":              "feed-items",
+	"ROBOTS_DISALLOW_ALL":     "robots-disallow-all",
+	"ROBOTS_DISALLOW":         "robots-disallow",
+	"ROBOTS_CRAWL_DELAY":      "robots-crawl-delay",
+	"ROBOTS_SITEMAPS":         "robots-sitemaps",
+	"VERIFY":                  "verify",
+	"VERIFY_DNS_SERVER":       "verify-dns-server",
+	"VERIFY_TIMEOUT":          "verify-timeout",
+	"VERIFY_CACHE_TTL":        "verify-cache-ttl",
+}
+
+func newFlagSet(cfg *Config, configFile *string) *flag.FlagSet {
+	fs := flag.NewFlagSet("gridlock", flag.ContinueOnError)
+	fs.StringVar(configFile, "config", *configFile, "path to a YAML config file (env CONFIG_FILE)")
+	fs.StringVar(&cfg.ListenAddr, "listen", cfg.ListenAddr, "address to listen on (env LISTEN_ADDR)")
+	fs.StringVar(&cfg.Domain, "domain", cfg.Domain, "base domain for generated links (env DOMAIN)")
+	fs.StringVar(&cfg.LogDir, "log-dir", cfg.LogDir, "directory for the daily stats files (env LOG_FILE_DIR)")
+	fs.StringVar(&cfg.EventDir, "event-dir", cfg.EventDir, "directory for the event log (env EVENT_DIR)")
+	fs.Int64Var(&cfg.EventSegmentSize, "event-segment-size", cfg.EventSegmentSize, "event log segment size in bytes (env EVENT_SEGMENT_SIZE)")
+	fs.DurationVar(&cfg.FlushInterval, "flush-interval", cfg.FlushInterval, "how often stats are flushed to disk (env FLUSH_INTERVAL)")
+	fs.DurationVar(&cfg.ShutdownTimeout, "shutdown-timeout", cfg.ShutdownTimeout, "time allowed to drain requests on shutdown (env SHUTDOWN_TIMEOUT)")
+	fs.DurationVar(&cfg.SessionIdle, "session-idle", cfg.SessionIdle, "idle time after which a client starts a new session (env SESSION_IDLE)")
+	fs.IntVar(&cfg.LinkCount, "link-count", cfg.LinkCount, "number of generated links per page (env LINK_COUNT)")
+	fs.StringVar(&cfg.LinkMode, "link-mode", cfg.LinkMode, "where links point: subdomain, path or both (env LINK_MODE)")
+	fs.StringVar(&cfg.Secret, "secret", cfg.Secret, "secret seeding the generated pages (env SECRET)")
+	fs.Var((*listFlag)(&cfg.TrustedProxies), "trusted-proxies", "comma separated CIDRs of proxies whose forwarding headers are trusted (env TRUSTED_PROXIES)")
+	fs.StringVar(&cfg.ClientIPHeader, "client-ip-header", cfg.ClientIPHeader, "forwarding header set by the trusted proxies: X-Forwarded-For, Forwarded or X-Real-IP (env CLIENT_IP_HEADER)")
+	fs.StringVar(&cfg.ASNDB, "asn-db", cfg.ASNDB, "IP to ASN CSV or TSV file, optionally gzipped (env ASN_DB)")
+	fs.StringVar(&cfg.TemplateDir, "template-dir", cfg.TemplateDir, "directory of page layouts overriding or adding to the built in ones (env TEMPLATE_DIR)")
+	fs.Var((*listFlag)(&cfg.Templates), "templates", "comma separated page layouts to use, all when empty (env TEMPLATES)")
+	fs.BoolVar(&cfg.Tarpit.Enabled, "tarpit", cfg.Tarpit.Enabled, "drip feed pages slowly (env TARPIT)")
+	fs.IntVar(&cfg.Tarpit.ChunkSize, "tarpit-chunk-size", cfg.Tarpit.ChunkSize, "bytes written between tarpit delays (env TARPIT_CHUNK_SIZE)")
+	fs.DurationVar(&cfg.Tarpit.MinDelay, "tarpit-min-delay", cfg.Tarpit.MinDelay, "shortest tarpit delay (env TARPIT_MIN_DELAY)")
+	fs.DurationVar(&cfg.Tarpit.MaxDelay, "tarpit-max-delay", cfg.Tarpit.MaxDelay, "longest tarpit delay (env TARPIT_MAX_DELAY)")
+	fs.IntVar(&cfg.Tarpit.MaxConns, "tarpit-max-conns", cfg.Tarpit.MaxConns, "slow responses allowed at once (env TARPIT_MAX_CONNS)")
+	fs.IntVar(&cfg.Tarpit.MaxConnsPerIP, "tarpit-max-conns-per-ip", cfg.Tarpit.MaxConnsPerIP, "slow responses allowed at once per client (env TARPIT_MAX_CONNS_PER_IP)")
+	fs.BoolVar(&cfg.Endless.Enabled, "endless", cfg.Endless.Enabled, "serve endless pages (env ENDLESS)")
+	fs.StringVar(&cfg.Endless.Path, "endless-path", cfg.Endless.Path, "route prefix of the endless pages (env ENDLESS_PATH)")
+	fs.Int64Var(&cfg.Endless.MaxBytes, "endless-max-bytes", cfg.Endless.MaxBytes, "bytes after which an endless page ends (env ENDLESS_MAX_BYTES)")
+	fs.DurationVar(&cfg.Endless.MaxDuration, "endless-max-duration", cfg.Endless.MaxDuration, "time after which an endless page ends (env ENDLESS_MAX_DURATION)")
+	fs.DurationVar(&cfg.Endless.Delay, "endless-delay", cfg.Endless.Delay, "pause between paragraphs of an endless page (env ENDLESS_DELAY)")
+	fs.BoolVar(&cfg.Sitemap.Enabled, "sitemap", cfg.Sitemap.Enabled, "serve generated sitemaps (env SITEMAP)")
+	fs.IntVar(&cfg.Sitemap.URLs, "sitemap-urls", cfg.Sitemap.URLs, "generated URLs per sitemap (env SITEMAP_URLS)")
+	fs.IntVar(&cfg.Sitemap.PerIndex, "sitemap-per-index", cfg.Sitemap.PerIndex, "sitemaps listed per sitemap index (env SITEMAP_PER_INDEX)")
+	fs.BoolVar(&cfg.Feed.Enabled, "feed", cfg.Feed.Enabled, "serve generated RSS and Atom feeds (env FEED)")
+	fs.IntVar(&cfg.Feed.Items, "feed-items", cfg.Feed.Items, "articles per feed (env FEED_ITEMS)")
+	fs.BoolVar(&cfg.Robots.Default.DisallowAll, "robots-disallow-all", cfg.Robots.Default.DisallowAll, "disallow everything in the default robots.txt (env ROBOTS_DISALLOW_ALL)")
+	fs.Var((*listFlag)(&cfg.Robots.Default.Disallow), "robots-disallow", "comma separated path prefixes disallowed by the default robots.txt (env ROBOTS_DISALLOW)")
+	fs.DurationVar(&cfg.Robots.Default.CrawlDelay, "robots-crawl-delay", cfg.Robots.Default.CrawlDelay, "crawl delay asked for by the default robots.txt (env ROBOTS_CRAWL_DELAY)")
+	fs.Var((*listFlag)(&cfg.Robots.Default.Sitemaps), "robots-sitemaps", "comma separated sitemaps announced by the default robots.txt (env ROBOTS_SITEMAPS)")
+	fs.BoolVar(&cfg.Verify.Enabled, "verify", cfg.Verify.Enabled, "verify search engine crawlers with reverse and forward DNS (env VERIFY)")
+	fs.StringVar(&cfg.Verify.DNSServer, "verify-dns-server", cfg.Verify.DNSServer, "host:port of the DNS server used for verification (env VERIFY_DNS_SERVER)")
+	fs.DurationVar(&cfg.Verify.Timeout, "verify-timeout", cfg.Verify.Timeout, "time allowed for the DNS lookups of one client (env VERIFY_TIMEOUT)")
+	fs.DurationVar(&cfg.Verify.CacheTTL, "verify-cache-ttl", cfg.Verify.CacheTTL, "how long verification results are cached (env VERIFY_CACHE_TTL)")
+	return fs
+}
+
+// loadConfig builds the Config from the defaults, the config file named by
+// -config or CONFIG_FILE, the environment and args, then validates it. The
+// arguments left after the flags are returned. An environment variable that
+// is set but empty sets an empty value, so a list from the file can be
+// cleared.
+func loadConfig(args []string, lookupEnv func(string) (string, bool)) (Config, []string, error) {
+	// the flags are parsed once up front only to find the config file, and
+	// again once the file and environment have been applied so that they
+	// take precedence over both.
+	scratch := defaultConfig()
+	configFile, _ := lookupEnv("CONFIG_FILE")
+	if err := newFlagSet(&scratch, &configFile).Parse(args); err != nil {
+		return Config{}, nil, err
+	}
+
+	cfg := defaultConfig()
+
+	if configFile != "" {
+		if err := cfg.loadFile(configFile); err != nil {
+			return Config{}, nil, err
+		}
+	}
+
+	fs := newFlagSet(&cfg, &configFile)
+	for env, name := range configEnv {
+		v, ok := lookupEnv(env)
+		if !ok {
+			continue
+		}
+		if err := fs.Set(name, v); err != nil {
+			return Config{}, nil, fmt.Errorf("%s: %w", env, err)
+		}
+	}
+
+	fs.SetOutput(new(bytes.Buffer))
+	if err := fs.Parse(args); err != nil {
+		return Config{}, nil, err
+	}
+
+	if err := cfg.validate(); err != nil {
+		return Config{}, nil, err
+	}
+
+	return cfg, fs.Args(), nil
+}
+
+func (cfg *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+func (cfg *Config) validate() error {
+	var errs []error
+
+	if _, port, err := net.SplitHostPort(cfg.ListenAddr); err != nil {
+		errs = append(errs, fmt.Errorf("listen_addr: %w", err))
+	} else if _, err := strconv.Atoi(port); err != nil {
+		errs = append(errs, fmt.Errorf("listen_addr: invalid port %q", port))
+	}
+
+	if cfg.Domain == "" {
+		errs = append(errs, errors.New("domain: must be set"))
+	}
+	if cfg.LogDir == "" {
+		errs = append(errs, errors.New("log_dir: must be set"))
+	}
+	if cfg.EventDir == "" {
+		errs = append(errs, errors.New("event_dir: must be set"))
+	}
+	if cfg.EventSegmentSize < 1<<10 {
+		errs = append(errs, errors.New("event_segment_size: must be at least 1024 bytes"))
+	}
+	if cfg.FlushInterval <= 0 {
+		errs = append(errs, errors.New("flush_interval: must be positive"))
+	}
+	if cfg.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("shutdown_timeout: must be positive"))
+	}
+	if cfg.SessionIdle <= 0 {
+		errs = append(errs, errors.New("session_idle: must be positive"))
+	}
+	if cfg.LinkCount < 1 || cfg.LinkCount > 100 {
+		errs = append(errs, errors.New("link_count: must be between 1 and 100"))
+	}
+	if cfg.Tarpit.Enabled {
+		if cfg.Tarpit.ChunkSize < 1 {
+			errs = append(errs, errors.New("tarpit.chunk_size: must be positive"))
+		}
+		if cfg.Tarpit.MinDelay < 0 || cfg.Tarpit.MaxDelay < cfg.Tarpit.MinDelay {
+			errs = append(errs, errors.New("tarpit: need 0 <= min_delay <= max_delay"))
+		}
+		if cfg.Tarpit.MaxConns < 1 {
+			errs = append(errs, errors.New("tarpit.max_connections: must be positive"))
+		}
+		if cfg.Tarpit.MaxConnsPerIP < 1 {
+			errs = append(errs, errors.New("tarpit.max_connections_per_ip: must be positive"))
+		}
+	}
+
+	if cfg.Endless.Enabled {
+		if !strings.HasPrefix(cfg.Endless.Path, "/") || !strings.HasSuffix(cfg.Endless.Path, "/") || len(cfg.Endless.Path) < 3 {
+			errs = append(errs, errors.New("endless.path: must be a path starting and ending with /"))
+		}
+		if cfg.Endless.MaxBytes < 1 {
+			errs = append(errs, errors.New("endless.max_bytes: must be positive"))
+		}
+		if cfg.Endless.MaxDuration <= 0 {
+			errs = append(errs, errors.New("endless.max_duration: must be positive"))
+		}
+		if cfg.Endless.Delay < 0 {
+			errs = append(errs, errors.New("endless.delay: must not be negative"))
+		}
+	}
+
+	if cfg.Sitemap.Enabled {
+		if cfg.Sitemap.URLs < 1 || cfg.Sitemap.URLs > sitemapMaxEntries {
+			errs = append(errs, fmt.Errorf("sitemap.urls: must be between 1 and %d", sitemapMaxEntries))
+		}
+		if cfg.Sitemap.PerIndex < 1 || cfg.Sitemap.PerIndex >= sitemapMaxEntries {
+			errs = append(errs, fmt.Errorf("sitemap.per_index: must be between 1 and %d", sitemapMaxEntries-1))
+		}
+	}
+
+	if cfg.Feed.Enabled && (cfg.Feed.Items < 1 || cfg.Feed.Items > 100) {
+		errs = append(errs, errors.New("feed.items: must be between 1 and 100"))
+	}
+
+	errs = append(errs, cfg.Robots.Default.validate("robots.default")...)
+	for host, p := range cfg.Robots.Hosts {
+		if host == "" || host == "*" {
+			errs = append(errs, errors.New("robots.hosts: host must not be empty"))
+		}
+		errs = append(errs, p.validate("robots.hosts."+host)...)
+	}
+
+	if cfg.Verify.Enabled {
+		if cfg.Verify.DNSServer != "" {
+			if _, _, err := net.SplitHostPort(cfg.Verify.DNSServer); err != nil {
+				errs = append(errs, fmt.Errorf("verify.dns_server: %w", err))
+			}
+		}
+		if cfg.Verify.Timeout <= 0 {
+			errs = append(errs, errors.New("verify.timeout: must be positive"))
+		}
+		if cfg.Verify.CacheTTL <= 0 {
+			errs = append(errs, errors.New("verify.cache_ttl: must be positive"))
+		}
+	}
+
+	if _, err := parseTrustedProxies(cfg.TrustedProxies); err != nil {
+		errs = append(errs, fmt.Errorf("trusted_proxies: %w", err))
+	}
+	if _, err := clientIPHeader(cfg.ClientIPHeader); err != nil {
+		errs = append(errs, fmt.Errorf("client_ip_header: %w", err))
+	}
+
+	switch cfg.LinkMode {
+	case linkModeSubdomain, linkModePath, linkModeBoth:
+	default:
+		errs = append(errs, fmt.Errorf("link_mode: unknown mode %q", cfg.LinkMode))
+	}
+
+	return errors.Join(errs...)
+}
+
+// listFlag is a comma separated flag value. Setting it replaces the whole
+// list, so a flag or environment variable overrides the file and defaults
+// rather than adding to them.
+type listFlag []string
+
+func (l *listFlag) String() string {
+	if l == nil {
+		return ""
+	}
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(v string) error {
+	*l = nil
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// envMap is a lookupEnv over a fixed set of variables.
+func envMap(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	}
+}
+
+// writeConfigFile writes a YAML config file and returns its path.
+func writeConfigFile(t *testing.T, yaml string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "gridlock.yml")
+	if err := os.WriteFile(path, []byte(yaml), 0o666); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigEmptyEnv(t *testing.T) {
+	path := writeConfigFile(t, "trusted_proxies: [10.0.0.0/8]\nsecret: from-file\n")
+
+	cfg, _, err := loadConfig(nil, envMap(map[string]string{
+		"CONFIG_FILE":     path,
+		"TRUSTED_PROXIES": "",
+		"SECRET":          "",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.TrustedProxies) != 0 {
+		t.Errorf("TrustedProxies = %v, want the empty variable to clear them", cfg.TrustedProxies)
+	}
+	if cfg.Secret != "" {
+		t.Errorf("Secret = %q, want the empty variable to clear it", cfg.Secret)
+	}
+
+	if _, _, err := loadConfig(nil, envMap(map[string]string{"LINK_COUNT": ""})); err == nil {
+		t.Error("empty LINK_COUNT accepted")
+	}
+}
+
+func TestLoadConfigPrecedence(t *testing.T) {
+	path := writeConfigFile(t, `
+domain: file.test
+link_count: 11
+link_mode: path
+secret: file-secret
+tarpit:
+  enabled: true
+  chunk_size: 32
+`)
+
+	tests := []struct {
+		name   string
+		env    map[string]string
+		args   []string
+		domain string
+		links  int
+		mode   string
+		secret string
+	}{
+		{name: "defaults", domain: "localhost:8070", links: 7, mode: linkModeSubdomain},
+		{
+			name:   "file over defaults",
+			env:    map[string]string{"CONFIG_FILE": path},
+			domain: "file.test", links: 11, mode: linkModePath, secret: "file-secret",
+		},
+		{
+			name:   "env over file",
+			env:    map[string]string{"CONFIG_FILE": path, "DOMAIN": "env.test", "LINK_COUNT": "12"},
+			domain: "env.test", links: 12, mode: linkModePath, secret: "file-secret",
+		},
+		{
+			name:   "flags over env",
+			env:    map[string]string{"CONFIG_FILE": path, "DOMAIN": "env.test", "LINK_COUNT": "12"},
+			args:   []string{"-domain", "flag.test", "-secret", "flag-secret"},
+			domain: "flag.test", links: 12, mode: linkModePath, secret: "flag-secret",
+		},
+		{
+			name:   "config file from a flag",
+			env:    map[string]string{"CONFIG_FILE": "/does/not/exist.yml"},
+			args:   []string{"-config", path},
+			domain: "file.test", links: 11, mode: linkModePath, secret: "file-secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _, err := loadConfig(tt.args, envMap(tt.env))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Domain != tt.domain {
+				t.Errorf("Domain = %q, want %q", cfg.Domain, tt.domain)
+			}
+			if cfg.LinkCount != tt.links {
+				t.Errorf("LinkCount = %d, want %d", cfg.LinkCount, tt.links)
+			}
+			if cfg.LinkMode != tt.mode {
+				t.Errorf("LinkMode = %q, want %q", cfg.LinkMode, tt.mode)
+			}
+			if cfg.Secret != tt.secret {
+				t.Errorf("Secret = %q, want %q", cfg.Secret, tt.secret)
+			}
+		})
+	}
+
+	// settings the file leaves out keep their defaults.
+	cfg, _, err := loadConfig(nil, envMap(map[string]string{"CONFIG_FILE": path}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.Tarpit.Enabled || cfg.Tarpit.ChunkSize != 32 || cfg.Tarpit.MaxConns != defaultConfig().Tarpit.MaxConns {
+		t.Errorf("Tarpit = %+v, want the file merged over the defaults", cfg.Tarpit)
+	}
+}
+
+func TestLoadConfigRest(t *testing.T) {
+	_, rest, err := loadConfig([]string{"-link-count", "3", "canary", "abc"}, envMap(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(rest, " ") != "canary abc" {
+		t.Fatalf("rest = %v, want [canary abc]", rest)
+	}
+}
+
+func TestLoadFileUnknownKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{name: "known keys", yaml: "domain: a.test\ntarpit:\n  enabled: true\n"},
+		{name: "empty file", yaml: ""},
+		{name: "unknown key", yaml: "domian: a.test\n", wantErr: "field domian not found"},
+		{name: "unknown nested key", yaml: "tarpit:\n  enable: true\n", wantErr: "field enable not found"},
+		{name: "wrong type", yaml: "link_count: many\n", wantErr: "cannot unmarshal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeConfigFile(t, tt.yaml)
+
+			cfg := defaultConfig()
+			err := cfg.loadFile(path)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) || !strings.Contains(err.Error(), path) {
+				t.Fatalf("loadFile error = %v, want one naming the file and containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(*Config)
+		wantErr []string
+	}{
+		{name: "defaults", change: func(*Config) {}},
+		{name: "listen without port", change: func(c *Config) { c.ListenAddr = "localhost" }, wantErr: []string{"listen_addr"}},
+		{name: "listen with a named port", change: func(c *Config) { c.ListenAddr = ":http" }, wantErr: []string{"listen_addr: invalid port"}},
+		{name: "no domain", change: func(c *Config) { c.Domain = "" }, wantErr: []string{"domain: must be set"}},
+		{name: "small segments", change: func(c *Config) { c.EventSegmentSize = 100 }, wantErr: []string{"event_segment_size"}},
+		{name: "no links", change: func(c *Config) { c.LinkCount = 0 }, wantErr: []string{"link_count"}},
+		{name: "too many links", change: func(c *Config) { c.LinkCount = 101 }, wantErr: []string{"link_count"}},
+		{name: "unknown link mode", change: func(c *Config) { c.LinkMode = "sideways" }, wantErr: []string{"link_mode"}},
+		{name: "bad proxy", change: func(c *Config) { c.TrustedProxies = []string{"10.0.0.0/33"} }, wantErr: []string{"trusted_proxies"}},
+		{name: "unknown client IP header", change: func(c *Config) { c.ClientIPHeader = "X-Client-IP" }, wantErr: []string{"client_ip_header"}},
+		{
+			name: "tarpit delays checked when enabled",
+			change: func(c *Config) {
+				c.Tarpit.Enabled = true
+				c.Tarpit.MinDelay, c.Tarpit.MaxDelay = 2, 1
+			},
+			wantErr: []string{"tarpit: need 0 <= min_delay <= max_delay"},
+		},
+		{name: "tarpit ignored when disabled", change: func(c *Config) { c.Tarpit.ChunkSize = 0 }},
+		{
+			name: "endless path",
+			change: func(c *Config) {
+				c.Endless.Enabled = true
+				c.Endless.Path = "/stream"
+			},
+			wantErr: []string{"endless.path"},
+		},
+		{
+			name: "every error reported",
+			change: func(c *Config) {
+				c.Domain = ""
+				c.LogDir = ""
+				c.FlushInterval = 0
+			},
+			wantErr: []string{"domain", "log_dir", "flush_interval"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := defaultConfig()
+			tt.change(&cfg)
+
+			err := cfg.validate()
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("validate passed, want errors %q", tt.wantErr)
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("validate error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package main
+
+import (
+	"cmp"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// Dashboard groupings of the hits over time.
+const (
+	groupDay   = "day"
+	groupWeek  = "week"
+	groupMonth = "month"
+)
+
+const (
+	chartWidth  = 800
+	chartHeight = 200
+	// dashboardTop is how many rows the top tables show.
+	dashboardTop = 20
+	// dashboardMaxDays is the longest range shown per day; longer ones
+	// are grouped by week.
+	dashboardMaxDays = 92
+)
+
+// dashboardBucket is the crawler hits of one day, week or month.
+type dashboardBucket struct {
+	Label string
+	Total int
+}
+
+// chartBar is one bar of the inline SVG chart, in SVG user units.
+type chartBar struct {
+	X, Y, Width, Height float64
+	Label               string
+	Total               int
+}
+
+// familyTotal is a row of the top families table.
+type familyTotal struct {
+	Name     string
+	Total    int
+	Agents   int
+	IPs      int
+	Networks int
+	Verified int
+	Spoofed  int
+	// Robots is the hits breaking robots.txt and RobotsFetched how often
+	// it was read.
+	Robots        int
+	RobotsFetched int
+}
+
+type dashboard struct {
+	From   string
+	To     string
+	Family string
+	Group  string
+
+	Total        int
+	Families     int
+	IPs          int
+	Networks     int
+	BehaviorBots int
+
+	Buckets   []*dashboardBucket
+	Bars      []chartBar
+	MaxBucket int
+	Kinds     []countRow
+
+	TopFamilies []familyTotal
+	TopIPs      []countRow
+	TopNetworks []countRow
+	TopASNs     []countRow
+}
+
+// bucketStart is the first day of the day, week or month t falls in.
+// Weeks start on Monday.
+func bucketStart(t time.Time, group string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	switch group {
+	case groupWeek:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case groupMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+	return day
+}
+
+func bucketLabel(t time.Time, group string) string {
+	if group == groupMonth {
+		return t.Format("2006-01")
+	}
+	return t.Format(dateLayout)
+}
+
+// buildDashboard lays the report out for the dashboard, filling days
+// without hits so the chart has no gaps.
+func buildDashboard(report *statsReport, rng statsRange, family, group string) *dashboard {
+	if group == groupDay && rng.Days() > dashboardMaxDays {
+		group = groupWeek
+	}
+
+	d := &dashboard{
+		From:        report.From,
+		To:          report.To,
+		Family:      family,
+		Group:       group,
+		Total:       report.Total,
+		Families:    len(report.Families),
+		IPs:         len(report.IPs),
+		Networks:    len(report.Networks),
+		Kinds:       topCounts(report.Kinds, 0),
+		TopIPs:      topCounts(report.IPs, dashboardTop),
+		TopNetworks: topCounts(report.Networks, dashboardTop),
+		TopASNs:     topCounts(asnCounts(report.ASNs), dashboardTop),
+	}
+
+	for _, n := range report.BehaviorBots {
+		d.BehaviorBots += n
+	}
+
+	buckets := map[string]*dashboardBucket{}
+	for day := rng.From; !day.After(rng.To); day = day.AddDate(0, 0, 1) {
+		start := bucketStart(day, group)
+		label := bucketLabel(start, group)
+		if _, ok := buckets[label]; !ok {
+			b := &dashboardBucket{Label: label}
+			buckets[label] = b
+			d.Buckets = append(d.Buckets, b)
+		}
+	}
+	for _, day := range report.Days {
+		t, err := time.ParseInLocation(dateLayout, day.Date, time.Local)
+		if err != nil {
+			continue
+		}
+		if b, ok := buckets[bucketLabel(bucketStart(t, group), group)]; ok {
+			b.Total += day.Total
+		}
+	}
+
+	for _, b := range d.Buckets {
+		d.MaxBucket = max(d.MaxBucket, b.Total)
+	}
+	if n := len(d.Buckets); n > 0 {
+		width := float64(chartWidth) / float64(n)
+		for i, b := range d.Buckets {
+			height := 0.0
+			if d.MaxBucket > 0 {
+				height = float64(b.Total) / float64(d.MaxBucket) * chartHeight
+			}
+			d.Bars = append(d.Bars, chartBar{
+				X:      float64(i) * width,
+				Y:      chartHeight - height,
+				Width:  max(width-1, 1),
+				Height: height,
+				Label:  b.Label,
+				Total:  b.Total,
+			})
+		}
+	}
+
+	for _, fs := range report.Families {
+		d.TopFamilies = append(d.TopFamilies, familyTotal{
+			Name:     fs.Name,
+			Total:    fs.Total,
+			Agents:   len(fs.UserAgents),
+			IPs:      fs.IPs,
+			Networks: fs.Networks,
+			Verified: fs.Verification[verifyVerified],
+			Spoofed:  fs.Verification[verifySpoofed],
+
+			Robots:        fs.Robots[robotsDisallowed] + fs.Robots[robotsCrawlDelay],
+			RobotsFetched: fs.RobotsFetched,
+		})
+	}
+	sort.Slice(d.TopFamilies, func(i, j int) bool {
+		if d.TopFamilies[i].Total != d.TopFamilies[j].Total {
+			return d.TopFamilies[i].Total > d.TopFamilies[j].Total
+		}
+		return d.TopFamilies[i].Name < d.TopFamilies[j].Name
+	})
+	if len(d.TopFamilies) > dashboardTop {
+		d.TopFamilies = d.TopFamilies[:dashboardTop]
+	}
+
+	return d
+}
+
+// dashboardHandler renders the stats overview for a date range, optionally
+// for a single crawler family, grouped by day, week or month.
+func dashboardHandler(reports *statsReports) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rng, err := parseStatsRange(r, time.Now())
+		if err != nil {
+			http.Error(w, "Invalid date range.", http.StatusBadRequest)
+			return
+		}
+
+		group := cmp.Or(r.URL.Query().Get("group"), groupDay)
+		if group != groupDay && group != groupWeek && group != groupMonth {
+			http.Error(w, "Unknown grouping.", http.StatusBadRequest)
+			return
+		}
+
+		family := r.URL.Query().Get("family")
+		report, err := reports.stats(rng, family)
+		if err != nil {
+			slog.Error("dashboardHandler: failed to read events", "error", err)
+			http.Error(w, "Could not read stats.", http.StatusInternalServerError)
+			return
+		}
+
+		if err := dashboardTemplate.Execute(w, buildDashboard(report, rng, family, group)); err != nil {
+			slog.Error("dashboardHandler: failed to render", "error", err)
+		}
+	}
+}
+
+var dashboardTemplate = template.Must(template.New("dashboard").Parse(`
+<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<title>Stats</title>
+<style>
+    body { font-family: monospace; margin: 20px; }
+    nav a, form { margin-right: 12px; }
+    .totals { display: flex; gap: 24px; margin: 16px 0; }
+    .totals div { border: 1px solid #ccc; padding: 8px 16px; }
+    .totals b { display: block; font-size: 1.6em; }
+    .tables { display: flex; flex-wrap: wrap; gap: 24px; }
+    table { border-collapse: collapse; }
+    th, td { padding: 2px 8px; text-align: left; }
+    td.n, th.n { text-align: right; }
+    th { cursor: pointer; border-bottom: 1px solid #999; }
+    svg rect { fill: #575cf5; }
+    svg rect:hover { fill: #db56db; }
+</style>
+</head>
+<body>
+<h1>Stats</h1>
+<nav>
+    <a href="/stats/files">day files</a>
+    <a href="/stats/sessions?from={{.From}}&amp;to={{.To}}&amp;family={{.Family}}">sessions</a>
+    <a href="/stats.json?from={{.From}}&amp;to={{.To}}&amp;family={{.Family}}">json</a>
+    <a href="/stats/clients.csv?by=ip&amp;from={{.From}}&amp;to={{.To}}&amp;family={{.Family}}">ips csv</a>
+</nav>
+<form>
+    <input type="date" name="from" value="{{.From}}"> to <input type="date" name="to" value="{{.To}}">
+    <input type="text" name="family" value="{{.Family}}" placeholder="family">
+    <select name="group">
+        <option value="day"{{if eq .Group "day"}} selected{{end}}>per day</option>
+        <option value="week"{{if eq .Group "week"}} selected{{end}}>per week</option>
+        <option value="month"{{if eq .Group "month"}} selected{{end}}>per month</option>
+    </select>
+    <button>Show</button>
+</form>
+
+<div class="totals">
+    <div><b>{{.Total}}</b>crawler hits</div>
+    <div><b>{{.Families}}</b>families</div>
+    <div><b>{{.IPs}}</b>IPs</div>
+    <div><b>{{.Networks}}</b>networks</div>
+    <div><b>{{.BehaviorBots}}</b>hits from bots by behaviour</div>
+    {{- range .Kinds}}
+    <div><b>{{.Count}}</b>{{.Key}} hits</div>
+    {{- end}}
+</div>
+
+<h2>Crawler hits per {{.Group}}</h2>
+<svg width="100%" viewBox="0 0 800 220" preserveAspectRatio="none" role="img" aria-label="crawler hits per {{.Group}}">
+    {{- range .Bars}}
+    <rect x="{{printf "%.2f" .X}}" y="{{printf "%.2f" .Y}}" width="{{printf "%.2f" .Width}}" height="{{printf "%.2f" .Height}}"><title>{{.Label}}: {{.Total}}</title></rect>
+    {{- end}}
+    <line x1="0" y1="200" x2="800" y2="200" stroke="#999" />
+    <text x="0" y="215" font-size="10">{{.From}}</text>
+    <text x="800" y="215" font-size="10" text-anchor="end">{{.To}}</text>
+    <text x="2" y="10" font-size="10">{{.MaxBucket}}</text>
+</svg>
+
+<div class="tables">
+<div>
+<h2>Totals</h2>
+<table class="sortable">
+<tr><th>{{.Group}}</th><th class="n">Hits</th></tr>
+{{- range .Buckets}}
+    <tr><td>{{.Label}}</td><td class="n">{{.Total}}</td></tr>
+{{- end}}
+</table>
+</div>
+
+<div>
+<h2>Top families</h2>
+<table class="sortable">
+<tr><th>Family</th><th class="n">Hits</th><th class="n">User agents</th><th class="n">IPs</th><th class="n">Networks</th><th class="n">Verified</th><th class="n">Spoofed</th><th class="n">Read robots.txt</th><th class="n">Robots violations</th></tr>
+{{- range .TopFamilies}}
+    <tr><td><a href="/stats?from={{$.From}}&amp;to={{$.To}}&amp;group={{$.Group}}&amp;family={{.Name}}">{{.Name}}</a></td><td class="n">{{.Total}}</td><td class="n">{{.Agents}}</td><td class="n">{{.IPs}}</td><td class="n">{{.Networks}}</td><td class="n">{{.Verified}}</td><td class="n">{{.Spoofed}}</td><td class="n">{{.RobotsFetched}}</td><td class="n">{{.Robots}}</td></tr>
+{{- end}}
+</table>
+</div>
+
+<div>
+<h2>Top IPs</h2>
+<table class="sortable">
+<tr><th>IP</th><th class="n">Hits</th></tr>
+{{- range .TopIPs}}
+    <tr><td>{{.Key}}</td><td class="n">{{.Count}}</td></tr>
+{{- end}}
+</table>
+</div>
+
+<div>
+<h2>Top networks</h2>
+<table class="sortable">
+<tr><th>Network</th><th class="n">Hits</th></tr>
+{{- range .TopNetworks}}
+    <tr><td>{{.Key}}</td><td class="n">{{.Count}}</td></tr>
+{{- end}}
+</table>
+</div>
+
+{{- if .TopASNs}}
+<div>
+<h2>Top ASNs</h2>
+<table class="sortable">
+<tr><th>ASN</th><th class="n">Hits</th></tr>
+{{- range .TopASNs}}
+    <tr><td>{{.Key}}</td><td class="n">{{.Count}}</td></tr>
+{{- end}}
+</table>
+</div>
+{{- end}}
+</div>
+
+<script>
+// clicking a header sorts its table by that column, again to reverse.
+document.querySelectorAll("table.sortable th").forEach(function (th) {
+    th.addEventListener("click", function () {
+        var table = th.closest("table");
+        var col = Array.prototype.indexOf.call(th.parentNode.children, th);
+        var rows = Array.prototype.slice.call(table.querySelectorAll("tr")).slice(1);
+        var dir = th.dataset.dir === "asc" ? -1 : 1;
+        th.dataset.dir = dir === 1 ? "asc" : "desc";
+        rows.sort(function (a, b) {
+            var x = a.children[col].textContent, y = b.children[col].textContent;
+            var nx = parseFloat(x), ny = parseFloat(y);
+            if (!isNaN(nx) && !isNaN(ny) && String(nx) === x && String(ny) === y) {
+                return (nx - ny) * dir;
+            }
+            return x.localeCompare(y) * dir;
+        });
+        rows.forEach(function (row) { row.parentNode.appendChild(row); });
+    });
+});
+</script>
+</body>
+</html>
+`))
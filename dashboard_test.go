@@ -0,0 +1,120 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseStatsRange(t *testing.T) {
+	now := time.Date(2026, time.March, 31, 15, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		query    string
+		from, to string
+		days     int
+		wantErr  bool
+	}{
+		{query: "", from: "2026-03-25", to: "2026-03-31", days: 7},
+		{query: "from=2026-01-01&to=2026-01-01", from: "2026-01-01", to: "2026-01-01", days: 1},
+		{query: "from=2025-01-01&to=2026-01-01", from: "2025-01-01", to: "2026-01-01", days: 366},
+		{query: "from=2024-01-01&to=2024-12-31", from: "2024-01-01", to: "2024-12-31", days: 366},
+		{query: "from=2024-01-01&to=2025-01-01", wantErr: true},
+		{query: "from=1000-01-01&to=2026-01-01", wantErr: true},
+		{query: "from=0001-01-01", wantErr: true},
+		{query: "from=2026-02-01&to=2026-01-01", wantErr: true},
+		{query: "from=yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/stats?"+tt.query, nil)
+			rng, err := parseStatsRange(r, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got range %v, want an error", rng)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := rng.From.Format(dateLayout); got != tt.from {
+				t.Errorf("From = %s, want %s", got, tt.from)
+			}
+			if got := rng.To.Format(dateLayout); got != tt.to {
+				t.Errorf("To = %s, want %s", got, tt.to)
+			}
+			if got := rng.Days(); got != tt.days {
+				t.Errorf("Days = %d, want %d", got, tt.days)
+			}
+		})
+	}
+}
+
+func TestBuildDashboardBuckets(t *testing.T) {
+	day := func(s string) time.Time {
+		t, _ := time.ParseInLocation(dateLayout, s, time.Local)
+		return t
+	}
+	report := &statsReport{
+		Days: []*statsDay{{Date: "2025-06-02", Total: 3}, {Date: "2025-06-04", Total: 2}},
+	}
+
+	tests := []struct {
+		name      string
+		rng       statsRange
+		group     string
+		wantGroup string
+		buckets   int
+	}{
+		{"a week per day", statsRange{day("2025-06-01"), day("2025-06-07")}, groupDay, groupDay, 7},
+		{"a quarter per day", statsRange{day("2025-04-01"), day("2025-07-01")}, groupDay, groupDay, 92},
+		{"a year per day", statsRange{day("2025-01-01"), day("2025-12-31")}, groupDay, groupWeek, 53},
+		{"a year per month", statsRange{day("2025-01-01"), day("2025-12-31")}, groupMonth, groupMonth, 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := buildDashboard(report, tt.rng, "", tt.group)
+			if d.Group != tt.wantGroup {
+				t.Errorf("Group = %s, want %s", d.Group, tt.wantGroup)
+			}
+			if len(d.Buckets) != tt.buckets || len(d.Bars) != tt.buckets {
+				t.Errorf("%d buckets and %d bars, want %d", len(d.Buckets), len(d.Bars), tt.buckets)
+			}
+
+			total := 0
+			for _, b := range d.Buckets {
+				total += b.Total
+			}
+			if total != 5 {
+				t.Errorf("buckets hold %d hits, want 5", total)
+			}
+		})
+	}
+}
+
+func TestDashboardHandlerRange(t *testing.T) {
+	events, err := OpenEventStore(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer events.Close()
+
+	handler := dashboardHandler(newStatsReports(events))
+	for query, want := range map[string]int{
+		"":                              http.StatusOK,
+		"from=2025-01-01&to=2025-12-31": http.StatusOK,
+		"from=1000-01-01&to=2026-01-01": http.StatusBadRequest,
+		"from=0001-01-01&to=2026-01-01": http.StatusBadRequest,
+		"group=fortnight":               http.StatusBadRequest,
+	} {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest("GET", "/stats?"+query, nil))
+		if w.Code != want {
+			t.Errorf("GET /stats?%s: status %d, want %d", query, w.Code, want)
+		}
+	}
+}
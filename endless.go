@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// EndlessConfig controls the endless page route.
+type EndlessConfig struct {
+	// Enabled serves pages under Path that never finish on their own.
+	Enabled bool `yaml:"enabled"`
+	// Path is the route prefix, it must start and end with a slash.
+	Path string `yaml:"path"`
+	// MaxBytes and MaxDuration end a stream that has gone on long enough.
+	MaxBytes    int64         `yaml:"max_bytes"`
+	MaxDuration time.Duration `yaml:"max_duration"`
+	// Delay is the pause between two generated paragraphs.
+	Delay time.Duration `yaml:"delay"`
+}
+
+// endlessLink points to a generated endless page.
+func endlessLink(rng *rand.Rand, path string) link {
+	name1 := names[rng.Intn(len(names))]
+	name2 := names[rng.Intn(len(names))]
+
+	return link{
+		URL:   path + strings.ToLower(name1+"-"+name2) + ".html",
+		Title: "The complete " + name1 + " " + name2 + " archive",
+	}
+}
+
+// serveEndless streams generated paragraphs and links as one chunked
+// response until the client goes away or a byte or time cap is reached.
+// The bytes sent and the time spent are recorded on the hit's event so we
+// can tell which crawlers have no response size limit.
+func (t *trap) serveEndless(w http.ResponseWriter, r *http.Request) {
+	ev := t.hit(r, kindEndless)
+
+	t.metrics.endlessActive.Add(1)
+	defer t.metrics.endlessActive.Add(-1)
+
+	cfg := t.cfg.Endless
+	rng := pageRand(t.cfg.Secret, r.Host, r.URL.Path)
+	name := pageName(r.Host, r.URL.Path)
+
+	setTrackingCookie(w, r)
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set("Server", servers[rng.Intn(len(servers))])
+	w.Header().Set("Content-Type", "text/html")
+
+	flusher, _ := w.(http.Flusher)
+	var (
+		buf     bytes.Buffer
+		written int64
+	)
+	// send renders one of the endless blocks and flushes it to the client.
+	send := func(block string, p page) bool {
+		buf.Reset()
+		if err := t.templates.endless.ExecuteTemplate(&buf, block, p); err != nil {
+			slog.Error("serveEndless: failed to render", "block", block, "error", err)
+			return false
+		}
+
+		n, err := w.Write(buf.Bytes())
+		written += int64(n)
+		t.metrics.bytesServed.Add(int64(n))
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return err == nil
+	}
+
+	start := time.Now()
+	ctx := r.Context()
+	deadline := time.NewTimer(cfg.MaxDuration)
+	defer deadline.Stop()
+
+	canary := newCanary(t.cfg.Secret, ev.Session, requestPage(ev.Host, ev.Path), t.cfg.Domain)
+	ev.Canaries = canary.Strings()
+	ok := send("endless_head", page{Name: name, Canary: canary})
+
+	for ok && written < cfg.MaxBytes {
+		chunk := page{
+			Sections: []section{{
+				Heading:    prose.Phrase(rng, 2, 5),
+				Paragraphs: []string{prose.Paragraph(rng)},
+			}},
+			Links: append(generateLinks(rng, t.cfg.LinkMode, t.cfg.Domain, 2), endlessLink(rng, cfg.Path)),
+		}
+		t.emitLinks(ev, chunk.Links)
+
+		if !send("endless_chunk", chunk) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			ok = false
+		case <-deadline.C:
+			ok = false
+		case <-time.After(cfg.Delay):
+		}
+	}
+
+	if ctx.Err() == nil {
+		send("endless_tail", page{})
+	}
+
+	ev.Bytes = written
+	ev.Duration = time.Since(start)
+	t.appendEvent(ev)
+
+	t.metrics.endlessStreams.Add(1)
+}
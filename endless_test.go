@@ -0,0 +1,44 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestServeEndlessEscapes(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Secret = "test"
+	cfg.Endless.Enabled = true
+	cfg.Endless.MaxBytes = 4 << 10
+	cfg.Endless.Delay = 0
+	tr := newTestTrap(t, cfg)
+
+	const payload = `<script>alert("x&y")</script>`
+	page := fetch(t, tr.serveEndless, payload+".evil.test", cfg.Endless.Path+payload+".html", "203.0.113.1:1000", "curl/8.0")
+
+	if strings.Contains(strings.ToLower(page), "<script") {
+		t.Error("script tag rendered unescaped")
+	}
+	if !strings.HasPrefix(page, "<!DOCTYPE html>") || !strings.HasSuffix(page, "</html>\n") {
+		t.Errorf("stream is not a whole page:\n%s", page)
+	}
+	if n := strings.Count(page, "<h2>"); n < 2 {
+		t.Errorf("%d chunks streamed before the byte cap, want several", n)
+	}
+
+	var canaries []string
+	if err := tr.events.Scan(func(ev Event) error {
+		canaries = ev.Canaries
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(canaries) != 3 {
+		t.Fatalf("event carries canaries %v", canaries)
+	}
+	for _, c := range canaries {
+		if !strings.Contains(page, c) {
+			t.Errorf("canary %q not on the page", c)
+		}
+	}
+}
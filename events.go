@@ -0,0 +1,408 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Event is a single request caught by the trap.
+type Event struct {
+	Time time.Time `json:"time"`
+	// Kind is what was served: page, image, endless, robots, sitemap or
+	// feed. Older events have no kind and were pages.
+	Kind       string `json:"kind,omitempty"`
+	Host       string `json:"host"`
+	Path       string `json:"path"`
+	RemoteAddr string `json:"remote_addr"`
+	// ClientIP is the client address after trusted proxy headers were
+	// applied. Older events only have RemoteAddr.
+	ClientIP      string `json:"client_ip,omitempty"`
+	XForwardedFor string `json:"x_forwarded_for,omitempty"`
+	// ASN and ASOrg are the autonomous system announcing ClientIP, when an
+	// IP to ASN database is configured.
+	ASN       uint32 `json:"asn,omitempty"`
+	ASOrg     string `json:"as_org,omitempty"`
+	UserAgent string `json:"user_agent"`
+	// Referer is the Referer header as sent.
+	Referer string `json:"referer,omitempty"`
+	// Session identifies the client's visit and Parent is the page, host
+	// and path, whose link led to this hit. Both are empty for images and
+	// Parent is empty for the first page of a session.
+	Session string `json:"session,omitempty"`
+	Parent  string `json:"parent,omitempty"`
+	Depth   int    `json:"depth"`
+	Crawler bool   `json:"crawler"`
+	// Behavior is the classifier's verdict from how the client behaved,
+	// independent of Crawler which comes from the user agent.
+	Behavior Verdict `json:"behavior"`
+	// Verification is the DNS check of a claimed crawler: verified,
+	// spoofed or unknown. Empty when verification is off or the client
+	// is not a known crawler.
+	Verification string `json:"verification,omitempty"`
+	// Robots is the robots.txt rule the hit broke, disallowed or
+	// crawl_delay, or empty.
+	Robots string `json:"robots,omitempty"`
+	// Canaries are the unique strings planted in the page served.
+	Canaries []string `json:"canaries,omitempty"`
+	// Bytes and Duration are filled in for endless pages once the client
+	// stops reading or a cap is hit.
+	Bytes    int64         `json:"bytes,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+}
+
+const (
+	segmentExt = ".seg"
+	// recordHeaderSize is the length prefix followed by the CRC32 of the
+	// payload, both big endian uint32s.
+	recordHeaderSize = 8
+	maxRecordSize    = 1 << 20
+
+	defaultSegmentSize = 64 << 20
+)
+
+var (
+	errCorruptRecord = errors.New("corrupt record")
+	crcTable         = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// EventStore is an append-only log of events split into numbered segment
+// files. Every record carries its own length and checksum so a torn write
+// at the end of a segment is detected and truncated away on open.
+type EventStore struct {
+	dir         string
+	segmentSize int64
+
+	mu   sync.Mutex
+	seq  int
+	file *os.File
+	size int64
+}
+
+// OpenEventStore opens the event log in dir, creating it if needed. The
+// newest segment is checked and any partially written tail is removed
+// before new events are appended to it.
+func OpenEventStore(dir string, segmentSize int64) (*EventStore, error) {
+	if segmentSize <= 0 {
+		segmentSize = defaultSegmentSize
+	}
+
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return nil, err
+	}
+
+	s := &EventStore{dir: dir, segmentSize: segmentSize}
+
+	segments, err := s.segments()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(segments) == 0 {
+		if err := s.openSegment(1); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+
+	last := segments[len(segments)-1]
+	if err := s.recoverSegment(last); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// ReadEventStore opens the event log in dir for scanning only, so it can be
+// read while a server is appending to it. Append on it fails.
+func ReadEventStore(dir string) (*EventStore, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+	return &EventStore{dir: dir}, nil
+}
+
+// recoverSegment opens the segment for appending, truncating it after the
+// last record that passes its checksum.
+func (s *EventStore) recoverSegment(seq int) error {
+	name := s.segmentPath(seq)
+
+	file, err := os.OpenFile(name, os.O_RDWR, 0o600)
+	if err != nil {
+		return err
+	}
+
+	valid, err := readRecords(file, func([]byte) error { return nil })
+	if err != nil && !errors.Is(err, errCorruptRecord) {
+		file.Close()
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	if valid < info.Size() {
+		slog.Warn("EventStore: truncating damaged segment tail",
+			"segment", name,
+			"size", info.Size(),
+			"valid", valid,
+		)
+		if err := file.Truncate(valid); err != nil {
+			file.Close()
+			return err
+		}
+	}
+
+	if _, err := file.Seek(valid, io.SeekStart); err != nil {
+		file.Close()
+		return err
+	}
+
+	s.seq = seq
+	s.file = file
+	s.size = valid
+	return nil
+}
+
+func (s *EventStore) openSegment(seq int) error {
+	file, err := os.OpenFile(s.segmentPath(seq), os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	s.seq = seq
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+// Append writes ev to the end of the log, rotating to a new segment once the
+// current one has reached the configured size.
+func (s *EventStore) Append(ev Event) error {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	if len(payload) > maxRecordSize {
+		return fmt.Errorf("event too large: %d bytes", len(payload))
+	}
+
+	record := make([]byte, recordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.Checksum(payload, crcTable))
+	copy(record[recordHeaderSize:], payload)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return os.ErrClosed
+	}
+
+	if s.size > 0 && s.size+int64(len(record)) > s.segmentSize {
+		if err := s.rotate(); err != nil {
+			// a segment is open either way; when the next one could not
+			// be opened the full one takes this event and the next append
+			// tries again.
+			slog.Error("EventStore: failed to rotate", "segment", s.segmentPath(s.seq), "error", err)
+		}
+	}
+
+	n, err := s.file.Write(record)
+	s.size += int64(n)
+	return err
+}
+
+// rotate moves appends on to the next segment. The current one stays open
+// until the next is, so a failure leaves the log writable.
+func (s *EventStore) rotate() error {
+	full := s.file
+	if err := s.openSegment(s.seq + 1); err != nil {
+		return err
+	}
+
+	err := full.Sync()
+	if cerr := full.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Sync flushes the current segment to stable storage.
+func (s *EventStore) Sync() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return os.ErrClosed
+	}
+	return s.file.Sync()
+}
+
+// Close syncs and closes the current segment. Appending after Close fails.
+func (s *EventStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.file.Sync()
+	if cerr := s.file.Close(); err == nil {
+		err = cerr
+	}
+	s.file = nil
+	return err
+}
+
+// Scan calls fn for every event in the log, oldest first. A damaged record
+// ends the scan of its segment; later segments are still read.
+func (s *EventStore) Scan(fn func(Event) error) error {
+	return s.ScanSince(time.Time{}, fn)
+}
+
+// ScanSince is Scan without the segments last written before since. An
+// event is appended no earlier than its time, so those segments only hold
+// older events; fn still sees older events from the segments that are read.
+func (s *EventStore) ScanSince(since time.Time, fn func(Event) error) error {
+	s.mu.Lock()
+	segments, err := s.segments()
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	for _, seq := range segments {
+		if !since.IsZero() {
+			info, err := os.Stat(s.segmentPath(seq))
+			if err == nil && info.ModTime().Before(since) {
+				continue
+			}
+		}
+
+		err := s.scanSegment(seq, fn)
+		if errors.Is(err, errCorruptRecord) {
+			slog.Warn("EventStore: skipping damaged records", "segment", s.segmentPath(seq), "error", err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *EventStore) scanSegment(seq int, fn func(Event) error) error {
+	file, err := os.Open(s.segmentPath(seq))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = readRecords(file, func(payload []byte) error {
+		var ev Event
+		if err := json.Unmarshal(payload, &ev); err != nil {
+			return fmt.Errorf("%w: %w", errCorruptRecord, err)
+		}
+		return fn(ev)
+	})
+	return err
+}
+
+func (s *EventStore) segmentPath(seq int) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%016d%s", seq, segmentExt))
+}
+
+// segments returns the sequence numbers of the segments on disk in order.
+func (s *EventStore) segments() ([]int, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var seqs []int
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+
+		var seq int
+		if _, err := fmt.Sscanf(strings.TrimSuffix(name, segmentExt), "%d", &seq); err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+
+	sort.Ints(seqs)
+	return seqs, nil
+}
+
+// readRecords reads framed records from r and passes each payload to fn. It
+// returns the offset just past the last good record. A short read at the end
+// is treated as a torn write and is not an error, a checksum mismatch
+// returns errCorruptRecord.
+func readRecords(r io.Reader, fn func([]byte) error) (int64, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, recordHeaderSize)
+
+	var offset int64
+	for {
+		if _, err := io.ReadFull(br, header); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return offset, nil
+			}
+			return offset, err
+		}
+
+		length := binary.BigEndian.Uint32(header[0:4])
+		sum := binary.BigEndian.Uint32(header[4:8])
+		if length > maxRecordSize {
+			return offset, fmt.Errorf("%w: length %d at offset %d", errCorruptRecord, length, offset)
+		}
+
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(br, payload); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return offset, nil
+			}
+			return offset, err
+		}
+
+		if crc32.Checksum(payload, crcTable) != sum {
+			return offset, fmt.Errorf("%w: checksum mismatch at offset %d", errCorruptRecord, offset)
+		}
+
+		if err := fn(payload); err != nil {
+			return offset, err
+		}
+
+		offset += recordHeaderSize + int64(length)
+	}
+}
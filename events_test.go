@@ -0,0 +1,214 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+// scanPaths returns the paths of the events in the log, oldest first.
+func scanPaths(t *testing.T, events *EventStore) []string {
+	t.Helper()
+
+	var paths []string
+	if err := events.Scan(func(ev Event) error {
+		paths = append(paths, ev.Path)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return paths
+}
+
+func TestEventStoreTornTail(t *testing.T) {
+	dir := t.TempDir()
+	events, err := OpenEventStore(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"/a", "/b"} {
+		if err := events.Append(Event{Path: path}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	events.Close()
+
+	// cut the last record short as if the process died mid-write.
+	name := events.segmentPath(1)
+	info, err := os.Stat(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(name, info.Size()-3); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err = OpenEventStore(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer events.Close()
+
+	if err := events.Append(Event{Path: "/c"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(scanPaths(t, events)); got != "[/a /c]" {
+		t.Fatalf("scanned %s after recovery, want [/a /c]", got)
+	}
+}
+
+func TestEventStoreChecksumMismatch(t *testing.T) {
+	dir := t.TempDir()
+	events, err := OpenEventStore(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer events.Close()
+
+	for _, path := range []string{"/a", "/b", "/c"} {
+		if err := events.Append(Event{Path: path}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	first, err := os.ReadFile(events.segmentPath(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := events.rotate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := events.Append(Event{Path: "/d"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// flip a byte in the payload of the second record.
+	second := len(first) / 3
+	first[second+recordHeaderSize+2] ^= 0xff
+	if err := os.WriteFile(events.segmentPath(1), first, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := fmt.Sprint(scanPaths(t, events)); got != "[/a /d]" {
+		t.Fatalf("scanned %s, want the damaged segment to stop at /a and the next to be read", got)
+	}
+}
+
+func TestEventStoreRotation(t *testing.T) {
+	payload, err := json.Marshal(Event{Path: "/0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	record := recordHeaderSize + len(payload)
+	// room for two records per segment.
+	events, err := OpenEventStore(t.TempDir(), int64(2*record+1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer events.Close()
+
+	for i := 0; i < 5; i++ {
+		if err := events.Append(Event{Path: fmt.Sprintf("/%d", i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	segments, err := events.segments()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(segments) != "[1 2 3]" {
+		t.Fatalf("segments %v, want [1 2 3]", segments)
+	}
+	for _, seq := range segments {
+		info, err := os.Stat(events.segmentPath(seq))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Size() > events.segmentSize {
+			t.Errorf("segment %d holds %d bytes, limit is %d", seq, info.Size(), events.segmentSize)
+		}
+	}
+	if got := fmt.Sprint(scanPaths(t, events)); got != "[/0 /1 /2 /3 /4]" {
+		t.Fatalf("scanned %s", got)
+	}
+}
+
+func TestEventStoreScanSince(t *testing.T) {
+	dir := t.TempDir()
+	// every event goes into a segment of its own.
+	events, err := OpenEventStore(dir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer events.Close()
+
+	now := time.Now()
+	old := now.AddDate(0, 0, -30)
+	for _, path := range []string{"/old-1", "/old-2", "/new"} {
+		if err := events.Append(Event{Time: now, Path: path}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// backdate the segments of the old events as if written a month ago.
+	for _, seq := range []int{1, 2} {
+		if err := os.Chtimes(events.segmentPath(seq), old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var all, recent []string
+	if err := events.Scan(func(ev Event) error {
+		all = append(all, ev.Path)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := events.ScanSince(now.AddDate(0, 0, -7), func(ev Event) error {
+		recent = append(recent, ev.Path)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(all) != 3 {
+		t.Fatalf("Scan saw %v, want all three events", all)
+	}
+	if len(recent) != 1 || recent[0] != "/new" {
+		t.Fatalf("ScanSince saw %v, want only /new", recent)
+	}
+}
+
+func TestEventStoreRotationFailure(t *testing.T) {
+	events, err := OpenEventStore(t.TempDir(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer events.Close()
+
+	// a directory in the way of the next segment makes rotation fail.
+	if err := os.Mkdir(events.segmentPath(2), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"/a", "/b"} {
+		if err := events.Append(Event{Path: path}); err != nil {
+			t.Fatalf("append with rotation failing: %v", err)
+		}
+	}
+
+	if err := os.Remove(events.segmentPath(2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := events.Append(Event{Path: "/c"}); err != nil {
+		t.Fatal(err)
+	}
+	if events.seq != 2 {
+		t.Fatalf("appending to segment %d, want rotation to be retried", events.seq)
+	}
+	if got := fmt.Sprint(scanPaths(t, events)); got != "[/a /b /c]" {
+		t.Fatalf("scanned %s", got)
+	}
+}
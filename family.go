@@ -0,0 +1,87 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	agents "github.com/monperrus/crawler-user-agents"
+)
+
+// Family is the crawler-user-agents entry a user agent matched. Every
+// version of a crawler's user agent matches the same entry so families are
+// used to group them.
+type Family struct {
+	Name    string `json:"name"`
+	Pattern string `json:"pattern"`
+	URL     string `json:"url,omitempty"`
+}
+
+// noFamily is the family of user agents that are not known crawlers.
+const noFamily = "none"
+
+var (
+	familyClass   = regexp.MustCompile(`\[(.)[^\]]*\]`)
+	familyNoise   = strings.NewReplacer(`\`, "", "^", "", "$", "", "(", "", ")", "", "?:", "", "*", "", "+", "", "?", "")
+	familiesByIdx = func() []Family {
+		families := make([]Family, len(agents.Crawlers))
+		seen := map[string]bool{}
+		for i, c := range agents.Crawlers {
+			name := familyName(c.Pattern)
+			// fall back to the pattern itself where two patterns would
+			// end up with the same readable name.
+			if name == "" || seen[name] {
+				name = c.Pattern
+			}
+			seen[name] = true
+
+			families[i] = Family{Name: name, Pattern: c.Pattern, URL: c.URL}
+		}
+		return families
+	}()
+)
+
+// familyName turns a crawler pattern into something readable, for example
+// `[wW]get` into "wget" and `Googlebot\/` into "Googlebot".
+func familyName(pattern string) string {
+	name := familyClass.ReplaceAllString(pattern, "$1")
+	name = familyNoise.Replace(name)
+	name = strings.Trim(name, " /.-_|")
+	if i := strings.IndexByte(name, '|'); i > 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+const familyCacheSize = 10000
+
+var familyCache = struct {
+	sync.Mutex
+	m map[string]Family
+}{m: map[string]Family{}}
+
+// crawlerFamily returns the family the user agent belongs to, or a Family
+// named noFamily when it is not a known crawler. Matching runs every crawler
+// pattern so results are cached.
+func crawlerFamily(userAgent string) Family {
+	familyCache.Lock()
+	f, ok := familyCache.m[userAgent]
+	familyCache.Unlock()
+	if ok {
+		return f
+	}
+
+	f = Family{Name: noFamily}
+	if matches := agents.MatchingCrawlers(userAgent); len(matches) > 0 {
+		f = familiesByIdx[matches[0]]
+	}
+
+	familyCache.Lock()
+	if len(familyCache.m) >= familyCacheSize {
+		clear(familyCache.m)
+	}
+	familyCache.m[userAgent] = f
+	familyCache.Unlock()
+
+	return f
+}
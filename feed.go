@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"log/slog"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// FeedConfig controls the generated RSS and Atom feeds.
+type FeedConfig struct {
+	// Enabled serves /feed.xml and /atom.xml on every host.
+	Enabled bool `yaml:"enabled"`
+	// Items is the number of articles in a feed.
+	Items int `yaml:"items"`
+}
+
+const (
+	rssPath  = "/feed.xml"
+	atomPath = "/atom.xml"
+)
+
+// feedItem is one generated article, rendered as an RSS item or an Atom
+// entry.
+type feedItem struct {
+	Title     string
+	Link      string
+	Author    string
+	Summary   string
+	Published time.Time
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	GUID        string `xml:"guid"`
+	Author      string `xml:"author"`
+	Description string `xml:"description"`
+	PubDate     string `xml:"pubDate"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title   string     `xml:"title"`
+	ID      string     `xml:"id"`
+	Link    atomLink   `xml:"link"`
+	Updated string     `xml:"updated"`
+	Author  atomAuthor `xml:"author"`
+	Summary string     `xml:"summary"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// feedItems generates the articles of a feed. Every article links to a
+// generated page and the newest was published within the last day.
+func feedItems(rng *rand.Rand, cfg Config, base string, now time.Time) []feedItem {
+	published := now.Truncate(time.Hour).Add(-time.Duration(rng.Intn(24)) * time.Hour)
+
+	links := generateLinks(rng, cfg.LinkMode, cfg.Domain, cfg.Feed.Items)
+	items := make([]feedItem, 0, len(links))
+	for _, l := range links {
+		link := l.URL
+		if strings.HasPrefix(link, "/") {
+			link = base + link
+		}
+		items = append(items, feedItem{
+			Title:     l.Title,
+			Link:      link,
+			Author:    names[rng.Intn(len(names))],
+			Summary:   prose.Paragraph(rng),
+			Published: published,
+		})
+		published = published.Add(-time.Duration(rng.Intn(48)+1) * time.Hour)
+	}
+	return items
+}
+
+// serveFeed serves the RSS feed on /feed.xml and the Atom feed on
+// /atom.xml, both listing the same generated articles for a host.
+func (t *trap) serveFeed(w http.ResponseWriter, r *http.Request) {
+	ev := t.hit(r, kindFeed)
+	t.appendEvent(ev)
+
+	rng := pageRand(t.cfg.Secret, r.Host, rssPath)
+	base := "http://" + r.Host
+	title := pageName(r.Host, "/")
+	host := strings.ToLower(r.Host)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	items := feedItems(rng, t.cfg, base, time.Now())
+
+	links := make([]link, len(items))
+	for i, item := range items {
+		links[i] = link{URL: item.Link, Title: item.Title}
+	}
+	t.emitLinks(ev, links)
+
+	var doc any
+	contentType := "application/rss+xml"
+	if r.URL.Path == atomPath {
+		contentType = "application/atom+xml"
+		feed := atomFeed{
+			Title:   title,
+			ID:      base + "/",
+			Updated: items[0].Published.Format(time.RFC3339),
+			Link:    atomLink{Href: base + atomPath, Rel: "self"},
+		}
+		for _, item := range items {
+			feed.Entries = append(feed.Entries, atomEntry{
+				Title:   item.Title,
+				ID:      item.Link,
+				Link:    atomLink{Href: item.Link},
+				Updated: item.Published.Format(time.RFC3339),
+				Author:  atomAuthor{Name: item.Author},
+				Summary: item.Summary,
+			})
+		}
+		doc = feed
+	} else {
+		feed := rssFeed{
+			Version: "2.0",
+			Channel: rssChannel{
+				Title:         title,
+				Link:          base + "/",
+				Description:   "The latest from " + title,
+				LastBuildDate: items[0].Published.Format(time.RFC1123Z),
+			},
+		}
+		for _, item := range items {
+			feed.Channel.Items = append(feed.Channel.Items, rssItem{
+				Title:       item.Title,
+				Link:        item.Link,
+				GUID:        item.Link,
+				Author:      strings.ToLower(item.Author) + "@" + host + " (" + item.Author + ")",
+				Description: item.Summary,
+				PubDate:     item.Published.Format(time.RFC1123Z),
+			})
+		}
+		doc = feed
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		slog.Error("serveFeed: failed to encode", "error", err)
+		http.Error(w, "Could not render feed.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set("Server", servers[rng.Intn(len(servers))])
+
+	written := t.write(w, r, buf.Bytes())
+	t.metrics.bytesServed.Add(int64(written))
+}
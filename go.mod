@@ -5,3 +5,5 @@ go 1.22.0
 require golang.org/x/text v0.14.0
 
 require github.com/monperrus/crawler-user-agents v0.0.0-20240409084354-0ef518e13a54
+
+require gopkg.in/yaml.v3 v3.0.1
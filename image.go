@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"log/slog"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	imageWidth  = 320
+	imageHeight = 200
+
+	// imageCacheSize caps the encoded images kept, a few KB each.
+	imageCacheSize = 2048
+)
+
+// images keeps recently served images encoded, so a crawler coming back to a
+// page is sent its image without it being drawn again.
+var images = &imageCache{entries: map[string]imageEntry{}}
+
+type imageCache struct {
+	mu      sync.Mutex
+	entries map[string]imageEntry
+}
+
+type imageEntry struct {
+	png  []byte
+	last time.Time
+}
+
+// get returns the PNG for seed, rendering and encoding it if it is not
+// cached.
+func (c *imageCache) get(seed uint64) ([]byte, error) {
+	key := strconv.FormatUint(seed, 16)
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if ok {
+		entry.last = now
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+	if ok {
+		return entry.png, nil
+	}
+
+	var buf bytes.Buffer
+	enc := png.Encoder{CompressionLevel: png.BestSpeed}
+	if err := enc.Encode(&buf, renderImage(int64(seed))); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= imageCacheSize {
+		evictOldest(c.entries, len(c.entries)/4, func(e imageEntry) time.Time { return e.last })
+	}
+	c.entries[key] = imageEntry{png: buf.Bytes(), last: now}
+	return buf.Bytes(), nil
+}
+
+// serveImage renders /img/<seed>.png. The seed is the hex number the page
+// generator put in the URL, so every image is stable for its URL.
+func (t *trap) serveImage(w http.ResponseWriter, r *http.Request) {
+	name, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/img/"), ".png")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	seed, err := strconv.ParseUint(name, 16, 64)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	t.recordHit(r, kindImage)
+
+	data, err := images.get(seed)
+	if err != nil {
+		slog.Error("serveImage: failed to encode image", "error", err)
+		http.Error(w, "Could not render image.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	n, _ := w.Write(data)
+	t.metrics.bytesServed.Add(int64(n))
+}
+
+// renderImage draws a banded sky, a few floating shapes and a space worm,
+// all chosen by seed.
+func renderImage(seed int64) *image.RGBA {
+	rng := rand.New(rand.NewSource(seed))
+	img := image.NewRGBA(image.Rect(0, 0, imageWidth, imageHeight))
+
+	// the sky is flat bands rather than per pixel noise, which PNG
+	// compresses to almost nothing.
+	top, bottom := randomColor(rng), randomColor(rng)
+	band := 4 + rng.Intn(12)
+	for y := 0; y < imageHeight; y++ {
+		c := lerpColor(top, bottom, float64(y-y%band)/imageHeight)
+		for x := 0; x < imageWidth; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+
+	stars := 30 + rng.Intn(60)
+	for i := 0; i < stars; i++ {
+		star := color.RGBA{255, 255, 255, uint8(120 + rng.Intn(136))}
+		fillCircle(img, rng.Intn(imageWidth), rng.Intn(imageHeight), 1+rng.Intn(2), star)
+	}
+
+	shapes := 3 + rng.Intn(6)
+	for i := 0; i < shapes; i++ {
+		c := randomColor(rng)
+		c.A = uint8(80 + rng.Intn(120))
+		x, y := rng.Intn(imageWidth), rng.Intn(imageHeight)
+		size := 10 + rng.Intn(70)
+		if rng.Intn(2) == 0 {
+			fillCircle(img, x, y, size/2, c)
+		} else {
+			fillRect(img, image.Rect(x, y, x+size, y+size*(1+rng.Intn(3))/2), c)
+		}
+	}
+
+	drawWorm(img, rng)
+
+	return img
+}
+
+// drawWorm draws a wobbling chain of segments that narrows towards the tail
+// and gives the head a pair of eyes.
+func drawWorm(img *image.RGBA, rng *rand.Rand) {
+	body := randomColor(rng)
+	stripe := randomColor(rng)
+
+	segments := 12 + rng.Intn(20)
+	radius := 14 + rng.Intn(16)
+	amplitude := 10 + rng.Float64()*50
+	frequency := 0.02 + rng.Float64()*0.05
+	phase := rng.Float64() * 2 * math.Pi
+	// segments overlap so the body reads as one worm.
+	step := float64(radius) * 0.6
+	length := int(step * float64(segments))
+	startX := radius + rng.Intn(max(1, imageWidth-2*radius-length))
+	midY := imageHeight/4 + rng.Intn(imageHeight/2)
+
+	var headX, headY int
+	for i := 0; i < segments; i++ {
+		x := startX + int(float64(i)*step)
+		y := midY + int(amplitude*math.Sin(float64(x)*frequency+phase))
+		r := radius * (segments + i) / (2 * segments)
+
+		c := body
+		if i%2 == 1 {
+			c = stripe
+		}
+		fillCircle(img, x, y, r+2, color.RGBA{0, 0, 0, 255})
+		fillCircle(img, x, y, r, c)
+
+		headX, headY = x, y
+	}
+
+	eye := radius / 3
+	for _, dy := range []int{-radius / 2, radius / 2} {
+		fillCircle(img, headX+radius/3, headY+dy, eye, color.RGBA{255, 255, 255, 255})
+		fillCircle(img, headX+radius/3+eye/3, headY+dy, eye/2, color.RGBA{200, 20, 20, 255})
+	}
+}
+
+func randomColor(rng *rand.Rand) color.RGBA {
+	return color.RGBA{uint8(rng.Intn(256)), uint8(rng.Intn(256)), uint8(rng.Intn(256)), 255}
+}
+
+func lerpColor(a, b color.RGBA, t float64) color.RGBA {
+	mix := func(x, y uint8) uint8 {
+		return uint8(float64(x) + (float64(y)-float64(x))*t)
+	}
+	return color.RGBA{mix(a.R, b.R), mix(a.G, b.G), mix(a.B, b.B), 255}
+}
+
+// blend paints c over the pixel at x, y using c's alpha.
+func blend(img *image.RGBA, x, y int, c color.RGBA) {
+	if !(image.Point{x, y}.In(img.Rect)) {
+		return
+	}
+
+	dst := img.RGBAAt(x, y)
+	a := int(c.A)
+	mix := func(s, d uint8) uint8 {
+		return uint8((int(s)*a + int(d)*(255-a)) / 255)
+	}
+	img.SetRGBA(x, y, color.RGBA{mix(c.R, dst.R), mix(c.G, dst.G), mix(c.B, dst.B), 255})
+}
+
+func fillCircle(img *image.RGBA, cx, cy, r int, c color.RGBA) {
+	for y := -r; y <= r; y++ {
+		for x := -r; x <= r; x++ {
+			if x*x+y*y <= r*r {
+				blend(img, cx+x, cy+y, c)
+			}
+		}
+	}
+}
+
+func fillRect(img *image.RGBA, rect image.Rectangle, c color.RGBA) {
+	rect = rect.Intersect(img.Rect)
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			blend(img, x, y, c)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestServeImage(t *testing.T) {
+	tr := newTestTrap(t, defaultConfig())
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		tr.serveImage(w, httptest.NewRequest("GET", path, nil))
+		return w
+	}
+
+	first, again := get("/img/1f2e3d.png"), get("/img/1f2e3d.png")
+	if first.Code != 200 || !bytes.Equal(first.Body.Bytes(), again.Body.Bytes()) {
+		t.Fatalf("status %d, want the same image for the same seed", first.Code)
+	}
+	if n := first.Body.Len(); n > 32<<10 {
+		t.Errorf("image is %d bytes, want it kept small", n)
+	}
+
+	img, err := png.Decode(first.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != imageWidth || b.Dy() != imageHeight {
+		t.Errorf("image is %dx%d", b.Dx(), b.Dy())
+	}
+
+	if w := get("/img/1f2e3d.png"); !bytes.Equal(w.Body.Bytes(), again.Body.Bytes()) {
+		t.Fatal("cached image differs from the rendered one")
+	}
+	for _, path := range []string{"/img/zz.png", "/img/1f2e3d.gif"} {
+		if w := get(path); w.Code != 404 {
+			t.Errorf("GET %s: status %d, want 404", path, w.Code)
+		}
+	}
+}
+
+func TestImageCacheLimit(t *testing.T) {
+	c := &imageCache{entries: map[string]imageEntry{}}
+	now := time.Now()
+	for i := 0; i < imageCacheSize; i++ {
+		c.entries[fmt.Sprintf("old-%d", i)] = imageEntry{last: now.Add(time.Duration(i) * time.Millisecond)}
+	}
+
+	if _, err := c.get(1); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.entries) > imageCacheSize {
+		t.Fatalf("caching %d images, cap is %d", len(c.entries), imageCacheSize)
+	}
+	if _, ok := c.entries["old-0"]; ok {
+		t.Fatal("oldest image was not evicted")
+	}
+	if _, ok := c.entries["1"]; !ok {
+		t.Fatal("new image not cached")
+	}
+}
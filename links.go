@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// Link modes decide where generated links point.
+const (
+	// linkModeSubdomain links to http://name-name-name.DOMAIN/, which needs
+	// wildcard DNS.
+	linkModeSubdomain = "subdomain"
+	// linkModePath links to deep paths on the current host.
+	linkModePath = "path"
+	// linkModeBoth mixes the two.
+	linkModeBoth = "both"
+)
+
+// pathSections are the first segment of generated paths. None of them may
+// collide with a fixed route.
+var pathSections = []string{
+	"wiki",
+	"blog",
+	"news",
+	"archive",
+	"people",
+	"articles",
+	"community",
+	"members",
+}
+
+type link struct {
+	URL   string
+	Title string
+}
+
+// generateLinks picks n links for a page from the names list.
+func generateLinks(rng *rand.Rand, mode, domain string, n int) []link {
+	links := make([]link, 0, n)
+	for i := 0; i < n; i++ {
+		// pick 3 random names from the list and use them as the link
+		// the link itself will be the name with spaces replaced with
+		// dashes and all lowercase and the title will be the names with
+		// spaces.
+		name1 := names[rng.Intn(len(names))]
+		name2 := names[rng.Intn(len(names))]
+		name3 := names[rng.Intn(len(names))]
+
+		title := strings.Join([]string{name1, name2, name3}, " ")
+
+		usePath := mode == linkModePath
+		if mode == linkModeBoth {
+			usePath = rng.Intn(2) == 0
+		}
+
+		var url string
+		if usePath {
+			section := pathSections[rng.Intn(len(pathSections))]
+			url = fmt.Sprintf("/%s/%s-%s/%s.html",
+				section,
+				strings.ToLower(name1),
+				strings.ToLower(name2),
+				strings.ToLower(name3),
+			)
+		} else {
+			subdomain := strings.ToLower(strings.Join([]string{name1, name2, name3}, "-"))
+			url = "http://" + subdomain + "." + domain + "/"
+		}
+
+		links = append(links, link{URL: url, Title: title})
+	}
+
+	return links
+}
+
+// pageName is the name a page is about. Generated paths name the page
+// after every segment below the section, otherwise it comes from the
+// subdomain.
+func pageName(host, path string) string {
+	caser := cases.Title(language.English)
+
+	var segments []string
+	for _, part := range strings.Split(path, "/") {
+		if part != "" {
+			segments = append(segments, part)
+		}
+	}
+	if len(segments) > 1 {
+		segments = segments[1:]
+	}
+	if len(segments) > 0 {
+		name := strings.TrimSuffix(strings.Join(segments, " "), ".html")
+		name = strings.ReplaceAll(name, "-", " ")
+		return caser.String(name)
+	}
+
+	currentName := "Ziggy"
+	// get current name from the subdomain
+	if strings.Contains(host, ".") {
+		currentName = strings.Split(host, ".")[0]
+		currentName = strings.ReplaceAll(currentName, "-", " ")
+		currentName = caser.String(currentName)
+	}
+
+	return currentName
+}
@@ -1,29 +1,24 @@
 package main
 
 import (
-	"cmp"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"html/template"
 	"io"
 	"log"
 	"log/slog"
-	"math/rand"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
 	"path/filepath"
-	"strconv"
+	"slices"
 	"strings"
+	"syscall"
 	"time"
-
-	agents "github.com/monperrus/crawler-user-agents"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
-)
-
-var (
-	stats  = map[string]int{}
-	ticker = time.NewTicker(10 * time.Minute)
 )
 
 func main() {
@@ -32,96 +27,196 @@ func main() {
 	}))
 	slog.SetDefault(logger)
 
-	domain := cmp.Or(os.Getenv("DOMAIN"), "0.0.0.0:8070")
+	args := os.Args[1:]
+	command := ""
+	if len(args) > 0 && args[0] == "canary" {
+		command, args = args[0], args[1:]
+	}
+
+	cfg, rest, err := loadConfig(args, os.LookupEnv)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if command == "canary" {
+		canaryCommand(cfg, rest)
+		return
+	}
+
+	if cfg.Secret == "" {
+		slog.Warn("no secret configured, generated pages can be predicted by anyone running gridlock")
+	}
+
+	stats := newMemoryStats()
+	metrics := newMetrics()
+
+	events, err := OpenEventStore(cfg.EventDir, cfg.EventSegmentSize)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	srv := http.NewServeMux()
-	srv.HandleFunc("/robots.txt", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "text/plain")
-		_, _ = w.Write([]byte(`
-        `))
-	})
 
 	srv.HandleFunc("/favicon.ico", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "image/x-icon")
 		_, _ = w.Write([]byte(``))
 	})
 
-	srv.HandleFunc("/stats", fileHandler)
+	reports := newStatsReports(events)
+	srv.HandleFunc("/stats", dashboardHandler(reports))
+	srv.HandleFunc("/stats/files", fileHandler(cfg.LogDir, reports))
+	srv.HandleFunc("/stats.json", statsJSONHandler(reports))
+	srv.HandleFunc("/stats/clients.csv", statsClientsHandler(reports))
+	srv.HandleFunc("/stats/sessions", sessionsHandler(reports))
+	srv.HandleFunc("/stats/sessions.json", sessionsJSONHandler(reports))
+	srv.Handle("/metrics", metrics)
 
-	srv.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		// generate links1 to 7 from the names list and populate the template
-		// with the links
-		slog.Debug("Request received",
-			"host", r.Host,
-			"user_agent", r.UserAgent(),
-			"remote_addr", r.RemoteAddr,
-			"x_forwarded_for", r.Header.Get("X-Forwarded-For"),
-		)
+	trap := &trap{
+		cfg:     cfg,
+		stats:   stats,
+		events:  events,
+		metrics: metrics,
 
-		if agents.IsCrawler(r.UserAgent()) {
-			slog.Info("crawler detected", "user_agent", r.UserAgent())
-			// remove all commas from simpler CSV handling.
-			ua := strings.ReplaceAll(r.UserAgent(), ",", "")
-			if _, ok := stats[ua]; !ok {
-				stats[ua] = 1
-			} else {
-				stats[ua]++
-			}
+		classifier: newClassifier(),
+		sessions:   newSessionTracker(cfg.SessionIdle),
+		robots:     newRobots(cfg.Robots, cfg.Sitemap.Enabled),
+	}
+	trap.ips, err = newClientIPResolver(cfg.TrustedProxies, cfg.ClientIPHeader)
+	if err != nil {
+		log.Fatal(err)
+	}
+	trap.templates, err = loadTemplates(cfg.TemplateDir, cfg.Templates)
+	if err != nil {
+		log.Fatal(err)
+	}
+	slog.Info("loaded page templates", "templates", trap.templates.names)
+	if cfg.ASNDB != "" {
+		trap.asn, err = loadASNDB(cfg.ASNDB)
+		if err != nil {
+			log.Fatal(err)
 		}
+		slog.Info("loaded IP to ASN database", "path", cfg.ASNDB, "ranges", len(trap.asn.ranges))
+	}
+	if cfg.Verify.Enabled {
+		trap.verifier = newVerifier(cfg.Verify, newResolver(cfg.Verify.DNSServer))
+	}
+	if cfg.Tarpit.Enabled {
+		trap.tarpit = newTarpit(cfg.Tarpit)
+	}
+	srv.HandleFunc("/robots.txt", trap.serveRobots)
+	if cfg.Feed.Enabled {
+		srv.HandleFunc(rssPath, trap.serveFeed)
+		srv.HandleFunc(atomPath, trap.serveFeed)
+	}
+	if cfg.Sitemap.Enabled {
+		srv.HandleFunc(sitemapPath, trap.serveSitemap)
+		srv.HandleFunc(sitemapPath+".gz", trap.serveSitemap)
+		srv.HandleFunc(sitemapPrefix, trap.serveSitemap)
+	}
+	srv.HandleFunc("/img/", trap.serveImage)
+	if cfg.Endless.Enabled {
+		srv.HandleFunc(cfg.Endless.Path, trap.serveEndless)
+	}
+	srv.HandleFunc("/", trap.servePage)
 
-		currentName := "Ziggy"
-		// get current name from the subdomain
-		if strings.Contains(r.Host, ".") {
-			currentName = strings.Split(r.Host, ".")[0]
-			currentName = strings.ReplaceAll(currentName, "-", " ")
-			caser := cases.Title(language.English)
-			currentName = caser.String(currentName)
-		}
+	flusher := &statsFlusher{
+		dir:      cfg.LogDir,
+		interval: cfg.FlushInterval,
+		recorder: stats,
+		events:   events,
+		metrics:  metrics,
+	}
 
-		baseLink := "http://%s." + domain + "/"
+	flushCtx, stopFlush := context.WithCancel(context.Background())
+	flushDone := make(chan struct{})
+	go func() {
+		defer close(flushDone)
+		flusher.run(flushCtx)
+	}()
 
-		content := indexTemplate
-		content = strings.ReplaceAll(content, "{{img}}", img)
-		content = strings.ReplaceAll(content, "{{current_name}}", currentName)
+	// requests see baseCtx cancelled as soon as shutdown starts so long
+	// running responses, endless pages and the tarpit, end instead of
+	// holding up the drain.
+	baseCtx, cancelBase := context.WithCancel(context.Background())
+	defer cancelBase()
 
-		for i := 1; i <= 7; i++ {
-			// pick 3 random names from the list and use them as the link
-			// the link itself will be the name with spaces replaced with
-			// dashes and all lowercase and the title will be the names with
-			// spaces.
-			name1 := names[rand.Intn(len(names))]
-			name2 := names[rand.Intn(len(names))]
-			name3 := names[rand.Intn(len(names))]
+	server := &http.Server{
+		Addr:        cfg.ListenAddr,
+		Handler:     srv,
+		ConnState:   metrics.connState,
+		BaseContext: func(net.Listener) context.Context { return baseCtx },
+	}
+	server.RegisterOnShutdown(cancelBase)
 
-			subdomain := strings.Join([]string{name1, name2, name3}, "-")
-			subdomain = strings.ToLower(subdomain)
-			link := fmt.Sprintf(baseLink, subdomain)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-			title := strings.Join([]string{name1, name2, name3}, " ")
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("Starting server", "address", server.Addr)
+		serveErr <- server.ListenAndServe()
+	}()
 
-			content = strings.ReplaceAll(content, fmt.Sprintf("{{link%d}}", i), link)
-			content = strings.ReplaceAll(content, fmt.Sprintf("{{link%d_title}}", i), title)
+	var serveFailed bool
+	select {
+	case err := <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("server stopped", "error", err)
+			serveFailed = true
 		}
+	case <-ctx.Done():
+		slog.Info("Shutting down", "timeout", cfg.ShutdownTimeout)
+	}
 
-		w.Header().Set("Keep-Alive", "timeout=5, max=1000")
-		w.Header().Set("Connection", "Keep-Alive")
-		w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
-		w.Header().Set("Server", servers[rand.Intn(len(servers))])
+	shutdown(server, cfg.ShutdownTimeout, stopFlush, flushDone, events)
 
-		w.Header().Set("Content-Type", "text/html")
-		_, _ = w.Write([]byte(content))
-	})
+	if serveFailed {
+		os.Exit(1)
+	}
+}
 
-	go func() {
-		err := writeStatsToFile()
+// shutdown stops server taking new connections and waits up to timeout for
+// the in flight requests so their hits are recorded, then stops the flusher,
+// which writes the final stats, and closes the event log.
+func shutdown(server *http.Server, timeout time.Duration, stopFlush context.CancelFunc, flushDone <-chan struct{}, events *EventStore) {
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Error("failed to drain connections", "error", err)
+	}
+
+	stopFlush()
+	<-flushDone
+
+	if err := events.Close(); err != nil {
+		slog.Error("failed to close the event log", "error", err)
+	}
+}
+
+// canaryCommand looks up the canaries found in args, or in stdin without
+// args, and exits non zero when none of them was served.
+func canaryCommand(cfg Config, args []string) {
+	text := strings.Join(args, " ")
+	if len(args) == 0 {
+		data, err := io.ReadAll(os.Stdin)
 		if err != nil {
-			slog.Error("writeStatsToFile: failed to write stats to file", "error", err)
+			log.Fatal(err)
 		}
-	}()
+		text = string(data)
+	}
 
-	slog.Info("Starting server", "address", "0.0.0.0:8070")
-	err := http.ListenAndServe("0.0.0.0:8070", srv)
-	log.Fatal(err)
+	events, err := ReadEventStore(cfg.EventDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := lookupCanary(events, text, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
 
 func safeJoin(baseDir, targetDir string) (string, error) {
@@ -144,142 +239,167 @@ func safeJoin(baseDir, targetDir string) (string, error) {
 	return targetPath, nil
 }
 
-func fileHandler(w http.ResponseWriter, r *http.Request) {
-	fileDir := cmp.Or(os.Getenv("LOG_FILE_DIR"), "logs/gridlock")
-
-	requestedDir, err := url.QueryUnescape(r.URL.Query().Get("dir"))
-	if err != nil {
-		http.Error(w, "Invalid path.", http.StatusBadRequest)
-		return
-	}
-
-	path, err := safeJoin(fileDir, requestedDir)
-	if err != nil {
-		http.Error(w, "Invalid path.", http.StatusBadRequest)
-		return
-	}
-
-	slog.Debug("reading path", "path", path)
+func fileHandler(fileDir string, reports *statsReports) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestedDir, err := url.QueryUnescape(r.URL.Query().Get("dir"))
+		if err != nil {
+			http.Error(w, "Invalid path.", http.StatusBadRequest)
+			return
+		}
 
-	fileInfo, err := os.Stat(path)
-	if err != nil {
-		http.Error(w, "File not found.", http.StatusNotFound)
-		return
-	}
+		path, err := safeJoin(fileDir, requestedDir)
+		if err != nil {
+			http.Error(w, "Invalid path.", http.StatusBadRequest)
+			return
+		}
 
-	slog.Debug("reading path", "path", path, "info", fileInfo)
+		slog.Debug("reading path", "path", path)
 
-	if fileInfo.IsDir() {
-		files, err := os.ReadDir(path)
+		fileInfo, err := os.Stat(path)
 		if err != nil {
-			http.Error(w, "Could not read directory.", http.StatusInternalServerError)
+			http.Error(w, "File not found.", http.StatusNotFound)
 			return
 		}
 
-		entries := make([]string, 0, len(files))
-		for _, file := range files {
-			entries = append(entries, url.QueryEscape(file.Name()))
-		}
+		slog.Debug("reading path", "path", path, "info", fileInfo)
 
-		fileTemplate.Execute(w, struct {
-			BaseDir string
-			Path    string
-			Entries []string
-		}{
-			BaseDir: fileDir,
-			Path:    requestedDir,
-			Entries: entries,
-		})
-	} else {
-		// Handle CSV file viewing
-		if strings.HasSuffix(path, ".csv") {
-			data, err := os.ReadFile(path)
+		if fileInfo.IsDir() {
+			files, err := os.ReadDir(path)
 			if err != nil {
-				http.Error(w, "Could not read file.", http.StatusInternalServerError)
+				http.Error(w, "Could not read directory.", http.StatusInternalServerError)
 				return
 			}
-			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
-			w.Write(data)
-		} else {
-			http.Error(w, "Unsupported file type.", http.StatusUnsupportedMediaType)
-		}
-	}
-}
-
-func writeStatsToFile() error {
-	fileDir := cmp.Or(os.Getenv("LOG_FILE_DIR"), "./logs/gridlock")
-
-	slog.Info("Configured to write stats file", "destination", fileDir)
-
-	for range ticker.C {
-		if len(stats) == 0 {
-			slog.Debug("writeStatsToFile: no stats to write")
-			continue
-		}
-
-		currentTime := time.Now()
-
-		dir := filepath.Join(fileDir, fmt.Sprint(currentTime.Year()), fmt.Sprint(currentTime.Month()))
-		slog.Debug("writeStatsToFile: creating directory", "dir", dir)
 
-		err := os.MkdirAll(dir, 0o777)
-		if err != nil {
-			return err
-		}
-		filename := filepath.Join(dir, fmt.Sprint(currentTime.Day())+".csv")
+			entries := make([]string, 0, len(files))
+			for _, file := range files {
+				entries = append(entries, url.QueryEscape(file.Name()))
+			}
 
-		file, err := os.OpenFile(filename, os.O_CREATE|os.O_RDWR, 0o700)
-		if err != nil {
-			slog.Error("writeStatsToFile: failed to open the stats file", "error", err)
-			// try again next tick
-			continue
-		}
+			fileTemplate.Execute(w, struct {
+				BaseDir string
+				Path    string
+				Entries []string
+			}{
+				BaseDir: fileDir,
+				Path:    requestedDir,
+				Entries: entries,
+			})
+		} else {
+			// Handle CSV file viewing
+			if strings.HasSuffix(path, ".csv") {
+				if r.URL.Query().Has("raw") {
+					data, err := os.ReadFile(path)
+					if err != nil {
+						http.Error(w, "Could not read file.", http.StatusInternalServerError)
+						return
+					}
+					w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+					w.Write(data)
+					return
+				}
 
-		// parse the csv file and write the stats to it
-		b, err := io.ReadAll(file)
-		if err != nil {
-			slog.Error("writeStatsToFile: failed to read the stats file", "error", err)
-			file.Close()
-			continue
-		}
+				counts, err := readStatsFile(path)
+				if err != nil {
+					http.Error(w, "Could not read file.", http.StatusInternalServerError)
+					return
+				}
 
-		data := strings.Split(string(b), "\n")
-		for k, v := range stats {
-			for i, line := range data {
-				if strings.Contains(line, k) {
-					value := strings.Split(line, ",")[1]
+				families := groupByFamily(counts)
+				family := r.URL.Query().Get("family")
+				if family != "" {
+					families = slices.DeleteFunc(families, func(f *familyRow) bool {
+						return f.Name != family
+					})
+				}
 
-					vInt, err := strconv.Atoi(value)
+				// the day's clients come from the event log, the file
+				// only has user agents.
+				var clients *statsReport
+				if day, err := time.ParseInLocation("2006/January/2.csv", strings.TrimPrefix(requestedDir, "/"), time.Local); err == nil {
+					clients, err = reports.stats(statsRange{From: day, To: day}, family)
 					if err != nil {
-						slog.Error("writeStatsToFile: failed to convert value to int", "error", err)
-						file.Close()
-						continue
+						slog.Error("fileHandler: failed to read events", "error", err)
 					}
+				}
 
-					data[i] = fmt.Sprintf(`"%s",%d`, k, vInt+v)
-					break
-				} else {
-					data[i] = fmt.Sprintf(`"%s",%d`, k, v)
+				data := struct {
+					Path     string
+					Day      string
+					Family   string
+					Families []*familyRow
+					IPs      []countRow
+					Networks []countRow
+					ASNs     []countRow
+				}{
+					Path:     requestedDir,
+					Family:   family,
+					Families: families,
 				}
+				if clients != nil {
+					data.Day = clients.From
+					data.IPs = topCounts(clients.IPs, 20)
+					data.Networks = topCounts(clients.Networks, 20)
+					data.ASNs = topCounts(asnCounts(clients.ASNs), 20)
+				}
+
+				statsFileTemplate.Execute(w, data)
+			} else {
+				http.Error(w, "Unsupported file type.", http.StatusUnsupportedMediaType)
 			}
 		}
+	}
+}
 
-		file.Truncate(0)
-		file.Seek(0, 0)
+// statsFlusher periodically writes the recorded stats to the day files.
+type statsFlusher struct {
+	dir      string
+	interval time.Duration
+	recorder StatsRecorder
+	events   *EventStore
+	metrics  *metrics
+}
 
-		_, err = file.Write([]byte(strings.Join(data, "\n")))
-		if err != nil {
-			slog.Error("writeStatsToFile: failed to write to file", "error", err)
-			file.Close()
-			continue
+// run flushes the recorded stats on every interval until ctx is cancelled,
+// then flushes one last time.
+func (f *statsFlusher) run(ctx context.Context) {
+	slog.Info("Configured to write stats file", "destination", f.dir, "interval", f.interval)
+
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			f.flush()
+		case <-ctx.Done():
+			f.flush()
+			return
 		}
+	}
+}
+
+func (f *statsFlusher) flush() {
+	if err := f.events.Sync(); err != nil {
+		slog.Error("statsFlusher: failed to sync the event log", "error", err)
+	}
 
-		// reset the stats
-		stats = map[string]int{}
-		file.Close()
+	stats := f.recorder.Reset()
+	if len(stats) == 0 {
+		slog.Debug("statsFlusher: no stats to write")
+		return
 	}
 
-	return nil
+	filename := statsFilePath(f.dir, time.Now())
+	slog.Debug("statsFlusher: writing stats", "file", filename)
+
+	if err := mergeStatsFile(filename, stats); err != nil {
+		slog.Error("statsFlusher: failed to write the stats file", "error", err)
+		f.metrics.flushErrors.Add(1)
+		// put the counts back and try again next tick
+		for k, v := range stats {
+			f.recorder.Add(k, v)
+		}
+	}
 }
 
 var fileTemplate = template.Must(template.New("files").Parse(`
@@ -287,74 +407,66 @@ var fileTemplate = template.Must(template.New("files").Parse(`
 <head><title>Stats</title></head>
 <body>
 <h1>Stats</h1>
+<p><a href="/stats">dashboard</a></p>
 <ul>
 {{- range .Entries}}
-    <li><a href="/stats?dir={{$.Path}}/{{.}}">{{.}}</a></li>
+    <li><a href="/stats/files?dir={{$.Path}}/{{.}}">{{.}}</a></li>
 {{- end}}
 </ul>
 </body>
 </html>
 `))
 
-var indexTemplate = `
-<!DOCTYPE html>
-<html lang="en">
-<head>
-    <meta charset="UTF-8" >
-    <meta name="viewport" content="width=device-width" >
-    <title>Friendly space worm site</title>
-    <style>
-        body {
-            font-family: "monospace";
-            margin: 20px;
-            padding: 20px;
-            background-color: #575cf5;
-            color: #e8c4c2;
-        }
-        img {
-            width: 100%;
-            max-width: 500px;
-            filter: drop-shadow(5px 5px 0px #3f4299);
-            border: 3px solid #db56db;
-            border-radius: 2px;
-        }
-        a {
-            color: #eeac0e;
-            padding: 4px;
-            display:block;
-        }
-        h1 {
-            color: #eeac0e;
-        }
-    </style>
-</head>
-
+var statsFileTemplate = template.Must(template.New("statsfile").Parse(`
+<html>
+<head><title>Stats</title></head>
 <body>
-    <div style="width:50%;">
-        <h1>Friendly space worm</h1>
-
-        Meet <strong>{{current_name}}</strong>, the sassy space worm from Andromeda. With their shimmering purple skin and glowing red eyes, they are a beguiling rogue, always the talk of the galaxy.
-
-        <p>This is your one stop shop to all things internet.</p>
-
-        <p>Here you find out everything you need to.</p>
-        
-        {{img}}
-
-        <div>
-            <h2>Here are some other sites you might like from our friendly web ring</h2>
-            <a href="{{link1}}">{{link1_title}}</a>
-            <a href="{{link2}}">{{link2_title}}</a>
-            <a href="{{link3}}">{{link3_title}}</a>
-            <a href="{{link4}}">{{link4_title}}</a>
-            <a href="{{link5}}">{{link5_title}}</a>
-            <a href="{{link6}}">{{link6_title}}</a>
-            <a href="{{link7}}">{{link7_title}}</a>
-        </div>
-    </div>
+<h1>Stats</h1>
+<p><a href="/stats/files?dir={{.Path}}&amp;raw">raw csv</a>{{if .Family}} | <a href="/stats/files?dir={{.Path}}">all families</a>{{end}}</p>
+<table>
+<tr><th>Family</th><th>Hits</th></tr>
+{{- range .Families}}
+    <tr><td><a href="/stats/files?dir={{$.Path}}&amp;family={{.Name}}">{{.Name}}</a></td><td>{{.Total}}</td></tr>
+    {{- if $.Family}}
+    {{- range .UserAgents}}
+    <tr><td>&nbsp;&nbsp;{{.UserAgent}}</td><td>{{.Count}}</td></tr>
+    {{- end}}
+    {{- end}}
+{{- end}}
+</table>
+{{- if .Day}}
+{{- if .IPs}}
+<h2>Top IPs</h2>
+<p><a href="/stats/clients.csv?by=ip&amp;from={{.Day}}&amp;to={{.Day}}{{if .Family}}&amp;family={{.Family}}{{end}}">csv</a></p>
+<table>
+<tr><th>IP</th><th>Hits</th></tr>
+{{- range .IPs}}
+    <tr><td>{{.Key}}</td><td>{{.Count}}</td></tr>
+{{- end}}
+</table>
+<h2>Top networks</h2>
+<p><a href="/stats/clients.csv?by=network&amp;from={{.Day}}&amp;to={{.Day}}{{if .Family}}&amp;family={{.Family}}{{end}}">csv</a></p>
+<table>
+<tr><th>Network</th><th>Hits</th></tr>
+{{- range .Networks}}
+    <tr><td>{{.Key}}</td><td>{{.Count}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .ASNs}}
+<h2>Top ASNs</h2>
+<p><a href="/stats/clients.csv?by=asn&amp;from={{.Day}}&amp;to={{.Day}}{{if .Family}}&amp;family={{.Family}}{{end}}">csv</a></p>
+<table>
+<tr><th>ASN</th><th>Hits</th></tr>
+{{- range .ASNs}}
+    <tr><td>{{.Key}}</td><td>{{.Count}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- end}}
 </body>
 </html>
-`
+`))
 
 var servers = []string{
 	"Apache/2.4.41 (Unix)",
@@ -1381,5 +1493,3 @@ var names = []string{
 	"Dangelo",
 	"Ethen",
 }
-
-var img = `<img alt="friendly space worm" title="friendly space worm" src="data:image/jpeg;base64,/9j/4AAQSkZJRgABAQAAAQABAAD/2wBDAAcFBQYFBAcGBgYIBwcICxILCwoKCxYPEA0SGhYbGhkWGRgcICgiHB4mHhgZIzAkJiorLS4tGyIyNTEsNSgsLSz/2wBDAQcICAsJCxULCxUsHRkdLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCz/wgARCAK8ArwDAREAAhEBAxEB/8QAHAAAAQUBAQEAAAAAAAAAAAAAAwECBAUGAAcI/8QAGwEAAwEBAQEBAAAAAAAAAAAAAAECAwQFBgf/2gAMAwEAAhADEAAAAPEPXxcKOIEOdl6FYYjeaB64uvAZ9dDr5fKj6ccfHo4FpOFxPUnqleb23Eq5c5cxWp81os+3K9HlcjiXMcJzlSSCUHVLknNOFYGEM14OE6jgUEGgcJQEmwaTTC0HNm65yIpippTU2lIr4AzSKudNTmzpEchBBtT4pEIzkKCIaOQh6xhztwEOrU+h54EgpRkBhijQUtCrjm+giacY3jfz1Z3p+dj59Ki5y4XIcxaCEK5c055vB9ZuQRpwKYqx7FacSQl5Lm3kvE6k9S5xMIjDUTgVnBwcDQ4OATbCmKmK2FHnWE4YmxaMGxaMGNPhsjRoDdItEloDBoU0OQg0T5DSuBALIQyirbgRnofbnFM4SUSVHRHmo82k0hSJINVqfXhBntwnBzlROY9yrl5L3BDNzbyHtEcucvaeQQHOXkvoKSRJ7kpD2EUuecmoKiUg5JZThc2JKNTi04jGFCLHOg1QyxjCNhQ1Q4thYimDatC57xXAWIraNqtstKbZbU0KQaI4aDYmZIxjFNmhyf0/p5GavPD69NStYUkVVHjUaoZSJ8SqcnTljZ9CtKDiXNOae4fWbyXNEM30iA8zK5KIzlxJCSCK8ztHMzkWk5aHN3eGtrjvY42fHflQFoGWGhkpqGuVqXVml5gvKPvMTXKr0mg6Jo6qIbBdiliKAWIoUaCNBq2AE1kZdCBDrJqprbFSTaDanwIU0EG+WcwiGyFIj2Hm+rYuXRT4Wb7vHxfRvBkjTqFUyW0pEOZK05YmXQ4HPNQdSc5e5I4KQ+oKSZ5vEVooimZiTmZiTmc0WtwrW8XRf8u8rHoiKoDiFeUa0KkKgRMagTkVILA1LGDY0EQNHFysqto0snI98IG+Gd6cszrUV6xlYDUSoRoGWNasjYYxiRNpTFbZbRtdzstoGmTBtbLFmfPCNuVNT01e9DOYZPonNxVXT4+b1qKWCW2Rpdmta5qTXHCW7hOE5pXJHLmivIrRjIojPMjkzDkSZiUZzjDWY1teLs0PH2CwuvFEvKLoBpCaFUhaFRFAOkhATkWmYCgNsaYMLGEjHzbGxoRNVUnHS6z1vCF6uHKdvLm9Nos9KreAZDLFGgjQaoc010xW1U0pqamwZlrEdFhlrKGtUT4VkewAwaStT6vzeN5z3VEQxMafIVaqTOrihrocCkkacsz0nEmvJ5NvGonC3zGcyHM2Mbqct9y9Wx4PRJx7V1YwdcY1QCiPRHoCwLBUgUgME0O5CMLBVLEMYMGA8cV0JjAYxhTQa2iB1TFUnC9Lz6aOuOD6HDi96rjoEUJMcsL1FNsWjB9N2WfRSaYsNGTSKmMV29ZxjRJbUTD02ENcc16Xn5uO0zh3QAahiODirGuWDO7mlJI08gtSYzNUGqDCkqZLymzGhxx9E5evYeb6Mbnqu3xiaTGeYakDAME2MQKTKA0gtCYCgFoIAciBlDRia6Ntbxe9hu7wQ0msYwYxAMBOkHytoOH0aXXNrts8H+h4uM6lAXQA0jLYBQp0GqY9GZ6MchNGLRo+TUFEBWk02iRPooLnC0vSsfOxmmUK2EpENS4FZYvngmziSOSNGINUSDKQ4K1KIlIvo5fTeHfZeX60PF1+2MO4Dcx6kDQgC0JgQj0mUBqQsjaKFRGYKgImC5gimDGDKoc7tMWUmgxsKaDShqqMqGaIUktBPByq15tt9jzG9Hw8h1xBOiIugK2BNiKCqYUxUNaonInp5TFvEatB8Cz6aNc5e8/WOTg826cw2BVcm0OBwrC+aGtXODNFclM5JFiTa47A6eKzjL0biXoPk+wHHet0wiVkDSYzkFA2miaAWo9ALUakG0BzFsjMEwVABhY1UyxCeGIQShKhMaNA4Ym2qhlR1YhoU0pk0rFcsnQbpwXnJp6dn50T0/Bym+0NdcZbCKBOjBhWjCmRSDSjhtVcHDBHrcpdc66eG15YxXZmIBo4SArHin3zxjV5BST3B1nKcTpU8Wry5fWPI9Kdw+hXmUHXGNrEe0ByMTKGiAKHcxKkVoVINIVABhCPbihHpgdMbeJ4hOYbcc0EUMfIQc3LrmR1Z7fzlQx0xVw2DEaNloDwCW0pWOYk3tOJ+h381j/V4qk7Yy6AGgBjLHNjVIPkytjmrfL0KrTiiad0vPLa5cxeTpw/diCsWCa2gKJzl7U85gmhXJ3mdzKM5pFmo9O4D0HxPbhzcPXCJUAuA1IKkTQWg0Rmo1yGpA5BYGkNoFMVMCIjYWBd855nBHZFdCdMTCU0aJoDVQyxhyGFCVjLbLQtoNLUBLRR77h9vDdnlRLzQJeOvsnF46+x8zlOjthLcK1EMKpk6NTR0oERwILc+dqJ7Ox9qg7Kzu/hCJQSDUS1JXBBT6wGg7mQ8pbmaRoM8vXvG9C+8r1a3Xng7QBwHSQ0o6QakNyBoTkFoNEcgFArTaUduMyPTGgTG0IjqYWRaoDBjYqENFTQGmxthQ0IrRUEsCsZSTbSmq3C4qPFtNJGfpRNOBlJzREcn6Fw4+g9vx+L9BQl0RzUC0aNJpSjBLvCYuZzMVn7jlUzl9RXtW9HickadxVl1ZEaKQUixrBtEhRLJsXjsudeu+H6zuPqrNcIemUa0G0FoLBEhpAtCpAvMIw1IKlKGtRGRmxsEwbBsGMLBUx0MQyxgwlsmhJiKGU1NhTFQlXDCWk0w0aUKdETQfAGbVMzrhRXaJcPmPc3vI/aj5fJ+v5lY+oC0Yr41LLtVn7H53da8vv4rXs+evT+dUlR85clxTy1IdeBCDkmedlWLlEtqcsvReN+oeF7UOZhXMbRRagGiAAmhVIhAtAuQOQuQ0h0msYwDIlywY6BMGxjBsa0lITYmDKEMZQihTYygtiixlCWrEcmMsSpq1ZOjCmJtVPcinRFWiz9Rtc2crl4EBQ5twpuZ7tzeFRex4FRfSMtVrInX1vzfTPz/S+NerpktOOuz8ZzzUbyXk6XL0aLTmbfIR5mIkVlIc2JjIInzl6bxa+ieJ7FaRArMGqDQCkIQHIqAVIGA0kLQnDWhMHQCkhq1bw9eETY6GglJzniepMqWUDKG2NUEoM0AqKWA1EqBOoimzSgEsU2xasWjZpibXSyCnThtBARPkcHArFadTPD9u5PDF63zdJpuptIm/W/F+z8l9D18N0ebWZ4sfmuckcuJe4IJ5JHBiJFTIeM0zsTGbOPqHn9G98j2aqs4VZx7QaQWmMjuQWRzOPcBqmsG0JwCiPQFpmg2o5pgOa6znM0i9yLfLaZls6b5AKUHTCn2jPaxRbEU2jlx1cdaR1qJUEvpoCsS1bLYtRrVktqOKZOjRIhJEKQfAg+DgLS6kWD23h8t/rfL03RoWdtb533nl/b253Lhh1i1+a+kVwRNTMlZlJOZnecl5y3lOItZ5/UPP13fje7VXjDuItoFA2guIzkFoFIVoZI3QbQnIGhMZaZSZUEctpEqZQtfy72/D69Jl6uLW+Y3KvQA56lKlXULSZTqssLLXgzPXw53r5cj11GWsY2BNBVDVx50EtWJsnc0dEFSwfFDm0SaUiaSNHwcHBwKC0njPK9s4/MJ6nzFV0bT8foMLr9JR4YQph1+UrRam1x6hVkPXhOZnrKU4lmU15WU4ek8N+keH9DUvCHtEVyKkGgLUSs49SKkGgVSJ1HqAWhNNoHS5yQzfSPpjLMtx5/qSfM+q8l37PMvQwqduYTQxIk101Vw+B8hFdjD3Geegyi9nzG7+fjfQM10KObDkjRsFWGNWLcufVBcjHyYo0Sa5DRIDZpBoCJqzhKDqZnL0e58PlxfY+cja3WHtZiPerRxMvKfUEcFArzKZyCZNYzHlOIsXzbrjy9Y8D6WsIha5RaBVIKQamNUxmgXIaQWRqkVAaQqTaGVLyTVD6ykXz6Tl6PQPJ+q8sPc8S9Plot+YbhARLm+GibRtBE0loNo+TtpNHESs42OOb9eSi9Lzs11xHz1BnqCdRLQRsOdBzbC2RbVTZpoNBqaS+TRnC4OApTqHuDpe8cfi0vs+FA0qKtoi3xnN7D7gryIIzyO1JM5bzmPKyeOtw5PZvn/pIGbga4xqTNJjKY9KPajtR7kFKPUiYGgVIVIdJtTJpFkDpkZ4bbn7N54n2HhPV2eQenx114spPBRKCgiObbLHLG22KHNNTSGlBGWwdM6nIQja9Hh4P1OaNFBz2BOoVqI1FNMnUc6sVNVMltQipsiD4aAocJaHFPM3NWGa96z+cx/r+bCNIhvjuf27ehk6C04DESFnKqZjxsXnfzze3fP+wPm6YOuQKgNzGJj0o9qPSi6TGaFUxWCY2kjQdE5olZTJ13fj/ZYL2vitRM+o+J9h4pr3+G+rxVd4tAlJ5LmcUrOQiATQJYo0FnTYbRtlqHCfbk6aS83aRUXTD2GvDw3p+bArUGdx51CtAzuNW6N4ypk0ObbNMBocmktCpS2jVhzSg5yRQS1dYV7WfJYv1OeGbYjn9+1ehY64+3iHMpTma4nGVo8PZPJ3t/K9eDrkCkKoA841KLaBUw7QKGUgORMjUgMdpKuWaJhD6k+HTM04/bPI+pzeHrfNHq4ZXoxa09orl7HgtHJxocLPR2V2csY2JOVcVVJxgSRqT3Rq10mXTnNOOypfSPP5Gb9LyvN+2Y03HjYC1Ga3XN9DnH5wSGzqIbUMVMT5NJaloQ6lwK08RKzfU6rhXp/d8bkeu8Lj9EUzkPMxmd5TXnOZYmXofJzejeF9DWVAakN5iai1lFpCuYdgGCaFZFcjpR7TaltodIDk7zPedtnp6f5vsaDzve8E7d/H/R46255ozkzHscxQiZ2HK5rT6VcAW2SNmx51Pz1UVXNBiUTKaWJ3RziHrV7C9Knh2Wvk+delxZ3So06gWwZ3BFCNTZd0IxEm1UNNJfDRNWcChzl4n1BKzatvTvP86+9n5HyqPqCkSHMhxKeM5xOJ0WfJ7d4HuQYqM5BcBqA1mCoC1C0QaBMiUQ7kLTbTaTKltoFSVxLrC95+nU+Z9bf4bZ134N6GeP7MXMaI5J6OY1VISC5dpKgwGJx1ULPSNjsHGm462i75r0zseZYCu6ibOtHc3d50WfTZZdtB1eV7HHFsdPL8q9HGkrSNOoVsCdhRoOLHNiKRaDkRvk3lsQebE8+ck0zJWTJ1JFez+b53mPucpyJDUmspRnOM7J8/s3j9lt53pwNMQ2uediXMOqIZ0W3nVGucVusqgVAqGWmVLKSOVuWVMl5WMnr/ifY/MHT3+rY4Z+68W9HF2+R3JU2JPHw9XLia5waQmgoCiLNwc9YuO0XGx46Hnazfdo36kdY4w8FQuCr0wxivWa4atnl+ds3fqGWPrmHmYD0vP896rjT0R50DG4YoJsyLGqaNiaj5U0pyEYpL6yfpmyNXKVWmi6fBPWZ3MsymPOwWOyx5vW/n/qKh4BqVub06fOc/ofn/0ZqNctthh69n4FZtyxrQ6BWh1PXDWkcrUkrM9R6f5fvYnj+t8Q9Pz99zZYPsdRuWWmUxpRR5Y1VwFwRHtRGRU4yuJJXTULLWHz6pjrPXfanoNaq68uc+iHlz1r53OtCKHM1LuxuvSa5/L3Tqj1/LKprk1e/m+adecI3jxuCbFGoZ2bLG2k0g2j5HDckrCXk9wKNXAo9V0fPyKzlvOSZzVFrGPtPj+knH6EPXFlKVU1ef0nyZ6yiPNolT1Kx9lXzMVqPY2p65a45yRotYnouOXr9Y8f6/5F7u3O9fnbzFeZ9NWGs3F52LXBUTRUShbaVD0Kduqm6WbrsdImbj523OlmnTZDY70AczCLI6al8jjXkaVzl5R3rZvs1lc+A04tDel3nz11R7HPk+T+nyQY3jLYOfQGbGaDVIm1NE0nS8x9Gk38y9w9KDtxQ9eWNnso1FqujwZVY2yVrjs3o8ncc/P6f4H1VQ8Itwllmn4nt9R4r3co2kQ4JFx77HzDSA6CPMVQyhzgtTMM/Q/L97S+d7+V22+UfWwBUb/HPzroq21L3TK/YJKgTjC9bx01GG/l2+GfdUjK3LUc1Cig5tmYkPhcnoDKx0ztds5pM6Th5qd89HVSSiDLV7F9+MPPvd+3Vzxef1h6vhlrdvF8f9PmjR0x8+gOewTVibSmLRsjZty3GZEnct8j7yhzu4CI1XT87KrHTZb+geb7mN9X4z2rxfTZydcLTEbA6xLleO9P0XjXUCTRCsn7c3uS+bC1wmVC1m+kd4yQ9F8r3sRzfTVktux4T6nLHg2xOO0LPRbC49Gwis0Xmd6LM2SuzHWOaGNIKqBnoPMhy2ycnwPguNYToybpIhsTFFHg0czp8p8zjphTTTTS335mfOs9O/1SunyCfF0Ou/uvDwUXo+J5h6GcXLoBG4Z2HNDKHNpNDL5No0lmUSNcYE9DkPHrun5qZWdi8p8xtsOf0/wPoKi8o2mUSk25eKsXofM/oenXhwErP1GvJ10+dGtc5W8TOLFZ7zg9mfwe9j33YXeOic905+adNS6UKFZbRMpael6Rgdph4tW9yj1ySipsDCCzy0jRdeMEIUV0E/TOd1ZE3zVNskeKjTTZtEByufk/QFONTq46OXVXHEQ02u3s0M+XM029d5eKuvhN2+Z5v3c0bPpBOoI2ZNjWrENm2FtkaMxMgyrzocDhbPr+amOLKc7A5vZvI6ZfnenXdGETTIdAmgNAtVWu2A6tozennmucsY+uYbnqk7yt1PrXkfTeb8/veF+pjnunlY5ch+WtatJDsTyk6TLtbpZHjaraydH0FnnfYGd2PJtHTjhrSvREkHi5tTb9mMjbF9JycabBFxocXOosbjzfQ+T2CUozFp05fmVeZvLs9fTmGI2/ZOcyPTwzIgvqfMY3Yi49MaNhTqOdBzo0pktg2KiypNZQFvwPRt+35maRZzhos+X2n533aSlX9GIqkFKLZBuRVnwMdNKj2o2mYalbT6xnPL1ryvfxXH9R86+xzVmvN1D6UhpAFx9FYan2mS41WmNpi6WtK5aHU7q8bTNQ3WZoz7KdXDliyd1rnP7OeRUFoHDGqixdfncLLYWOsnDpOto0Qhc46PQdvM840y0efXh8+Qr3sL7OXM+9/YM+/wAy14NPL9WjwsJ6fh4PocXLoHn1x0hRswpqoctpb0SDKAtlG9G57fmJ5narH1PzTQeR69V088XWY9KNWcDQi6ZtoUTChtOW8PXGLU9ech5ajNei+B9t8sepvi+jntXMLaZdKbUmqUzqt5OiFsWVGkI9Vzjy41wT1uEr2s/RsouBZfU80d0GbaO2taHpwBrk4YEByqFGtTht2XRL5/TBjFcuKMZKxxcx9u81MPr523nq83w5S3setgxyydO7f6dGGePoGe+qjiql49P7PhZtdlx5v2eZ7PlgS2Gg1o1Mc09OQZQJ25tyPQOz5aweduuf3LwPQrc96zbGLai1MLSYtzG0lGPEIceraaV+mfOC3nMMPSuPtzHm/XeB+m4NL0TI867ue61zkJSazkMLhtjY21Fxqc5pHVOry2esses0xjON2oG3U08aART95vd8VCFjtDTrsdomFdzeynPdNHnRzDmldEq3VVjPVI056zTPc12+d4eeetQzlwSdeuz01rs8fRDvrTn2OHEbt+d899LjBl1R1qGNgxoNaNlsWjkGMoE9CsVHovZ8pYuNlz8vqfz/AL2f6OeDrEKlC0mO4iaIVUNy8UaqjNx6FqH1L3Ep4+m+b6XkOP1Xk2vSSOi+qqXr8yXvhrt8oLzthMLg4Vn89/cZzxFrzmNoOep2SNcvSHnIUqng1VtcP2m12zdTFm6Weik59Kjn2tOb2anLhgnLwcSxw5WfTWRG02yq05Jx1WU6ZePPfViWRKs1bl0tkZ7l+lm7x9dwwra8mL6ngZDXSNluGdhToJajmmTqsozzr46HBweldnydk8/WfMVt5nsUnVyQKmDrMVkXSRUBaYN1RCegqBMI5ZSe8pN4+h+f6PknJ9Z5pv31+PnlL0enq1vZ5F10c2i1wnC1ma8uXTqonJK4k1WqqzKlFZVLrHytIHssZU2s1G8zNFQOq2dslh0wOXebz+vTx54TJ6aORmTCXlS66bB3SPhl3tbR20U+XGUoSrb7s96oJEaS/Qq2vTM86Ost6vGyfo+NlNdI0bhjUcWJaDWiyzGUCOnm+R6f2fJWay9y8Lsq8+mj6eWFcxLIdoNg2MAVDWCWkPRNZYxpGvFl4HrLR4dGA877HzTXqi48cu+l+el/XrVHoeHterjvTLW5LHPRYv1TDHxXo2w+dwMauJqZrEfSNg1eOEir241cnnm90K1x+HQTD0j4+gCOuQuyEcMFeWw5YhzNMzmk59NW+Gbp0yCqzPjjkNc8mSm90bTcU4ldza6gmm7h5t83o0TK18Tzn1/Ji5dAI2HOoZsS1VM5nXZ9PD4PUuz5LSZ83s/zfs5PoxqunKLSr7IuhzQxRSgWiF17bKSNuBKjqyPUSmged9J47n7HRkSeqxn3LV+ufbjruz5fRb82lji0N55etqWbiFFi8Ly1baY6XYv6iYBZrU5klPz/AHrGZ9FJy9ehy9rTLtsY9p56dElnF41EfOCOCOcMczc2+katH1NlpnoKwv3ESuTN49OOy7CVaJIpPWo2HfVphZeOb2V9WZXBN9b5bLXcaOgGfQKWJbdLMZ1sdXJqHq3Z8j6DzZbTwPYzfbx1eyitVuxzTWR3EZ01aAshtsZzbajiC0pbx9N4fWXj9f5816KB6hy7L+PrNdX0uhvCFXy3nXZ8/Mvh2GvBucFB1KMrKrXBcnSfTC33jY6noWGZZrDba5M0o8OruT0dHHu+kcq93y+TxG/1eK6PpMiunKz5meXzIzjrjyRuH1Njeka8DUjap8k3E08Z6vo58vqvNeX04ucF10WDnZq6bWIoTk9lfo+enD7hr4fkXp+dAnoBn0BnUK06aKZ1sdfI4PWuz5D1rytTcXXRdGEPVVtEXVdUBaj0RXpDG6nETQFsUTnJHJnnc49Pef7/AI2+ntOiNzdegj7DdP6LYPl9Rw+Hym3L80dozp864vjmGIWZ+emr49xGczqm1vGYUNa1kbA5ey8y9iTj1ehZ+p7JyfPbHT5vIv6LB9n1mIr0sbHm50+Z45qyvGC8z07HWJ2kTqKAkSBpxoOyPXXlWdmHmfD3LWrYRNNiO3588FZ+sa+pgI4/U7yN1fO+d9mUfLrBOolfTo8zrs+tA4PWuv4/2rweyoTqt5gWq6nH1hEejcf0HlvRjWdnjgqDpxikp8HCURLm4mfSPM+jxmfZ47soN6i5ey5n6zdH1G5fH6Hz/I6iPB8v0+s8E7ozGvzjNOZrlkNmEiWb9dDXmZ6vjrn49xMajG26X1npGZtOf5rXz87na9jDd31WEr1cZPk0J80i5u18qojA+jn653+wFy9zGRTQ40uHmHz09qpYTs58Vw9DjV1UStIuHL1L0Lb08XHH7NfTTx4+Z9jwoUdQc9QlItCCrs+nhoP13p+N9t+d9LL7Z1W6iCr9HE0kM9FLj91hejz9hr8xPvjeEIpjfBwzVmao9b832PPOf2cJ1YQ4upKuo66zH39qvst5V7HHwtfj87k6+g816fc860+YpZ8SPXNHfKS4bpmHGlz1krpMiAuU67dA/qNofRbVGrz8LSZeJWX6GI6fpsVWuQz8EBw0S+cturDN8+N30mt3ophBrOyqae1ECtVBkm823o+HUDq4vOR0fP0Ot9IHLBavXbejmc+T2nTv85jz9r6XzGL1uPnqKdWwyWV0dCpFR7D0fJ+ufOepk+nCt2UCiDqQ6I4/OT3YNb6WvJuXwyKUB6cJWnNFecpz755Hvefc3veKejw18zHmtVHTk8+vRz9luj3dW+a8z82nXfiNN8Lr8xno+Tj1hI6a2eyn1hj8tqrmsGWLZbizT03D+g0z+j2S9LVzw2y4YD6crr6lXWeSy+do14kzo8jPxmry2m+uk1yFebbxj3FYylHVZaR4oGVLGnssVjfQyxnnX2mwefFBOd3mvbWLH1/Tu8qz4fXu3wPPOvkjxuLPRpTiq+NraT1nx+w/u/Eb3wfSy3VjBsr9lDshtRm4tXVPaSpe85NKE3wLSVzJc+gc3T6X5X0vy51oGuNlV1UuBNWuPTns/Q0E/U6RezLdwiQPnzL+Rzj+dtdVadsesxnm+jmwsdNHxdHZqHAsW8o09Vke1fV7l+dlk7g1VCuSHHl0x4lnt5leRVLPTdF2W+MPTG9zkNKh2zrZqtw1BhukjJic+rRV36S/LyhlmsbDjCjfWkm7etfT9ezzCOD6EOPyj2Pn65bBjVJvlpBjWWXZ8nq+xdv59pPD78/15VWqh2QqUSkCmEoLCKotEhzEejiUqXtSyfevO9Xxnn+lwPd5xqLRF09K42zWLPNjy7IE9hTra+cL80D4yKNHtNHrnsbEnXOofJnMHFynpuSaWG+8uXEx3Y+ki2aSJclxXJYb5UYSWvQ9qn6QycG0Y/fnzKK3Go3Pq2KI9GiZMKPTv6bQV49Vvx4rhzQTqtw1tW0+jtNdPPY4feo6Inf8n5p25Az2HGiLWHHR00Oj6JPkbfx+3M9UQtlXWQKQaAjaNQiOo9XNeMU2ZTVwcz0eOuf4vpvO9UbaLTXLawel5VMfR50deARFyzs86izcNzCIGR6xrx4K6Y7k7ZyAhTpDyoifLWvyI+NOdS70sFZBJLfQSlUmbtJu9JtdJIg1KHWef0wqcKg8+o86dNPpm2I+eKq+V31e7ezxRN+TFcvLwK6exrm/Xt3WnLj4w9uXZB08TJ+r4dYthTo2N4UbMGir6Nn5MvmddF2RWaKuqRNhojjcEZ3FrRUr/O6e9IemRayKTf8AF6vkGXsJWb9hGprnQWaeOquOl5WaUwcs7iSpmZVz7A/MyY8LrWm3j2Xl2qdY81uqqWsVV59EFVByiVC5bOrQjq0rSfdWVRpKz06m2mI1z59c4jbmpMbiY0LCyTqoxzm/RytdQmDR9nppz3xnlS9JxPP57nTZl2lNUbF/RVOvh18X7PPX5rfL6V3fNee7oM6pn0QZ0GWxX9Gz8gvndeb65rrI7kTcakApBxXoOm1mk5e2k0uFtwkclan8fsefZd6SotW97O25plK5tbvfm0Bq3LbGvagyzlVhrtc05nSXjU61fD1kEuTAbHKgrTM5712RfLRk7PnVy16+cemVzWly9USrjOpcV9Y1E5QcWHBuWpjccQA5n2iVT6s+jBAsbb6foMNfz+nXfio8dsyrG1L1rt9vcxM/PyJ6fZn0+P6c3tl+N5p6PEKNWZ7wp2EqYX9GT8iLzuzOdcQrmLRHbjsaUNgzUFMYSjOG9UG8klqw4/Soc/Sy0U4EehJ7A1gTflttJvXJShDpYoxRy5Welbr58/fHRVN7F67GsjqZGNs3F10Xcz10GccNxq+KtDug1xNvDqwltcFUTGgbkzmjo0DObSZGnSxwx4zruBONvt0wIwk4+nrK6MhXHeztj48hFHMQJ523O22ajy5K7PZK7PGn53vJz+Y+t4QVqLLeHPQOW0r6Dn5LvN7s525wbmK3EYxtowjE9Q0AHKIiPVQcS5l5nrn8vcyOFyGBW4sKKtX3TN+ZriQ6SehuE2m6r5Wku6Iwuunmvbzs2hw8tGtRHTGyZTqFKr55Hp829asmJBs9tpDCWOVBZpBRoxAseQ53N27ja3GjilaOHETdNh5q8z9WnvzHvsnZYZk87gQHlXR6Nbp50ZTOXf6je/k55v0Hlt5r7ny1fNAy6Yy6BxYg95Pj5nl+nl+3GFZCbBQMbGxzQL0juhDmGUR68HCI5kBZ4e15nhvMraNn0R8cSGsbPlcVKe/MY5c6tr2irVawOaxNMSVRqGOEnUeRYLtiGEVc1ibckjg87V8clnpoPG4E4CpVc8jQK9j1uF844niZFdUvbp4Sac3GSTYUrGPQfWVe8b49LPZeRFeKAoTTrY8otYKVZz6O62rziPP9+z7hd3yvmnZjHjojRuGNxh7bXxl35PrY/t54V1DYFsTaTq6dqzeAD4JRnFN+ZwlM3UrePR865vSnKhR0w8kR0HLGPOHI5lvt2zLd5e1DljHBgpBQ3qU6H3MaMJJcGctBe8TPHYz24efMudtp+W2HnztlvrgsOeXVGiqgw4JV9rrI08jIiRfTM07DO0vERyxIklOdHcqzq65Ztd1zlpkp8bqaokVqiAVjw3mlxn6Vt08+Vnl96z9Cffh+b+r5dOt42fSKWMfr+nxms8b2MP24wrIrcWqit8nFGG9UBwpJER6tKaHBzUhaQuT3qFTZPorcgabihRnHy5mJTdNrrXa6NURZuoiN9kZZuIq9VxXn21a6V5lqtzl0Yd8m2rq8NnyNxv0Sufo81Xl6G9M/Ob02ia7nV28yLXLwjvUr26s0eYYyC5KaTjras4BjIfTp13ZTLyI1ZqBKfAIzRnAQ012fq5vo8waftseiV+TWdfDiermjR0aDDvpqy9B2+I3vj+rh+zCvuojcJ2xtg47EG9hlOr4ffxHd5QGDpoCiK5Nn6+a5+yNESzWFNNT4pkSLLJiznaaTFpZXelNa6Z9k56w91d5r1PHn8+fU8jNdmtTGWfrDTVv5hPJa2o8a5lcvClOwFTX1O0bazaJCJ2uTFM7RyE6moYgGO0id4CxUqQ97k7qqPLrXzKN9UikZHN8Cjer1b9TLX5JTq9eO40cWc7OQHR5NK7DGw0/Rr+U9H8nswXUq3Rw6qEaDZGq2g8FARXFxDZGmlPJ6m94vVA5/arMNa3PMxoMfA6R61ctokcSIeTc1uQrdYaZPTPc46eg4xjNHiNJ1F6+THI1ySjYvqxU80MhonNzjsnV11+vny9kCYtbyQq93zyyqQnAzcaWyZdGxo1a6fLuq66efNr5x6mRjUmuWtKCp83bR2t1zrnyzK9D0XPsmzhiO7l2F+VkNcQTqyK9C0+X9D83bFa1VauDTjGsZsTfCemGmMvgAbNKaxQcS6kRxY564rj9geavKmHUldREGsjTUqPVr+fjYsnDOiwLuXWenM8uvqNIrzTyiOdw+jELm9Z39Dz+ca5ZonY12Mcrpw2HVBhWFKEKslwpA40MtZ0fNEVnWsck7qctKRecB58m8GSm1K0cxU+Q8u5XdU7cA0rVevo2a3LbzXu8vdvnye/nR3okG+fz3oHHGUjpo+hQqcZ6RymMeAUBrRo2lBNWttG8ngdQZxKMpPP62G5+jeEZBvmWTQbIWkOjQnP7dZzeXa6dVrEamav4fhGnEjJKXqE9OJM6Ax9r19DyLPj33T34iYa2pT75JeuE3TMbIMEGGxXEybpchdd7l6co6RGcGuGufO9uIuSMsWuVT5jZntDgVhWMloFpHZD25wGTletXu1F8Hp+Pp+U93iegGub6vFCqHD3R4Gzx5oXJ3ZTpmFTiuxXXBHKA64pg0KEWwvmOEgK5K5M4noq+f2JGDo5Ta0PvnDytqmy7cRYd5OLsDc3r09D58wTfmb5M0+X0BdmorfIk0S5PQ9vVxD5hGkac4952lZyOjlRzV50i0iwxyCgPOj5cmNq6+dGTp6njirlgnMgcLgQOpdqnD4JFgpbJDrUlEZyiiTPVoT0Kgy9FL816eLeV0UfV8+NUKXtZ8S6fLofO7MF1TBqhVYW4hQKtoKMarmwPRo3AgKNWiOSvMrzOXX8/Yfk66x7QaQ6g+dsvO53iz4/donkjjZZ7rMgDzteb6genZbPIk1+fLabehRxhcFA04qt4Wm0whQcSZPTCnUJLETc+t6dbXDJnebG/CrHwwlzo0iSD4Fa60uqKzm5dkZUzMWGR0K4ZIqc5dlibzp6idHmZpvf69FN1fPinTs+nVnzs18248/TzvouOustOg1xjUMLVDW0KYUJ6oHFME4biX1JHD2imZjNZ0dx+nDz6XjyeZLosejDQr0aDm0jvKwfYoW72h5+YbT026RCWU8yocXcvpptPM0G5l1zyWqnF2C6BZ9LoGuDTSTpUrzgqODmczg5CI4OYtJ2hzS0jaKbqX2Hq5Y4QZQRttSOTgetLld8G+bdz7Pnunz0p+hvOh0e3iDVsnbVngznz7TlyxB1VWlx7cJker4EGirlQ23NxzUYKUjTxPY+snmZWiVmVSciXx+tmubqtpqrDP6xqdA09wc259PKqwW4rnxC6JekWkY2Na4PLj299tV0eVH02r8sqnFkVTs+4OE15xiEhPByOBQRnAiOBWc11i2uBRTd0SiLF2eXpVM8PPNdAOaUfKpi3kvQTrVm+Hry7c9jadnFTX5ojQcvRPyJhzaGeUvH1Y/e4NVCtipoU0YyxlcnzsJSAQbGOB4lrMrl7khkWoM87SGzn9jz7m6XYzKan9E7mXVXpAfbczGIzfpHQeZ4xqNqsiQk5COWXO0nsygwVHNUnn9BkRDz5opHBzlQUOK4loIHArHUcJNBWkAukzdJDNiGzGuVvvJKAwLL4FmrB9cOubZx7OU08iOPVnt3Hf4Va8hKmjup8iW8J182v4HgNuistxqbRjqgmogQ04BlNHw+BRvErSiJUvJJUGvIqykPKxy0Pwe75lnUJTstc9fU0mtTDqaoctLi4yiux1RanNZxAFHzqdu6nnRuXvg488ZZIJHPByOBA4ODgcDrHUMkQXaJWG2RHI5vkxzXSpGyDmhSIhw+KPOrKmUt9C98lXnHOrfz7he/5uqqRTo0du/GkmUkw13PhkToptdAtitxzRhQTVstKGjQfD5UonNqk6oeJ9SWsyODVidZ6XFeveF9H88+hzYKdNsZ2mqpda1dOvDQBE0IyqpZa0sPkwxUoK2GXl9B+CpjgQSBwcHBwICsfS6msjEIjmlZ2idaLQ4GFjzD6S6yNkJI1DhqNS1BxWun08nfkjbtY9fTLew7vKrbxCtFK0M+VFrmkPnsTjtOTbDdO4XQHQnYlYnbFS02oaVw2jchwKS65eSSk9wZwWsZJnr+edl5fseJ+niPn2psndbFfotfsq8dqHN11EaXGHR4OLNkjTs+iXzaUB5qD5HMUOYrXDUSDRJqaBwK11hNJLoKhBjmiAXWQSDgahUImoKUrbld3HoK86KuN7rb4e/Qa+dseuK+8WS0Lsa8ZryM8JTx1fJjgtuyDVCqxDGauXRGeKNoqRnI4ago1cuae5IQ60V5nrCUZaPBew+V6Pyf6NtGmGnp0T5t0PRbILTFU0IoVsushxJZMdyx1zsaoTzmpcjmcLhoLhojg4ODmKDqT9U7ROb5tElSk046ASkSam4fJ8NxXCetJ2fRMu6R8aDtY9O+z1rejPWbxDrLixJv18MqzM85RjaTzCy6crvuFsLbRjWzQRjE0DgcNG1SfQ4lwnuCVJayPWUwy9N867Pm7PKOzHzJ9Ys36lmZzVA1D25gMCIyOisi24dBcOx+VUp5aAiEDg4FDhcPhI3wcCsdSdoJQRnILSLYJSCGgMQ+mkpBuVok4bzSVlpYLqodvN5UU11Uezmq8fXV7dptxho4bU26eWR85lkd4SDC8wyxG/ox2AoagTrlSMYNQ4pUcPhOocJzTzN9I1ZGrKY+b33w+vHXv5D1Y0BrRzv6Nks30zIbjNWjdcAhxU4mO9xy+vXZedWGHEoCBwczg5HBwcxQ4OpLQrFYWwjXMFKYhWNA1jqBSPGxUOEZ6cqk47S1dNrxKUsu3n0nXlX1l6Ce4bXlGUWbm0Tq80sPO68K3yGMZueIlvnttgsEA3SByprtiOYqOZzFSc05p5JCS3jJeM6uX6U+d9Lw70s8Ka5u5iRVzjq7aIl09paIxQJI2euo5PczEePEMkRwcCBwczg5HM4ODgc09hNBWc0OQUisWhbUnQdQJoqEVRs08t5qzMlRs0xhvPh8lMXXOrenrz7o9Xenoo83u9A3mOrhotfKvYq+5+vOXwuXMcznxGb17IjQ2xgjfBPw9GHrzCrNrFScCsUlzl7gxJayk1hPOX6k+b9P5b9vC7xrx46IbTIe7FlNBoyNxXTIrQ8vs12XFTVxKLm+BUI0o0Fw1YrFa5nI5NomQNRwlo6h+iLqKx1zJbYEPJkGU1DAsBJuO8mtcqRKSuk5pAriI726+j07HltpzdFTbcceeSBpheiBjpHOYpEgwbOlDtsMGA1nDR0iEDmuBQcS4HtEJfWZ6xlVzT1ze/+D6HmHZHh3ct9yaeYztBT2szmNbDScUIdpl3zuPfLV5HMQODhKHN8JQQfI4SD5HBzFZzXBzFtLQfRStA9EYUdBkcUDJjyp0jFLtBG2ylGSdnOhPHmTz0PT497Svm7SM/q63XnqnnC18y4x7GVnbYdEpZx5BXgQxrXrAqmg2miGgjOBBcxwKDweZEaJWR3jLvm0uPL7L4HpeL+tl57255jHfW4FJnVMr0o8ro3ULOmx5PexJ8+xLmIHBwcHBwcHI4OZwKLh8HWLQ6kXRG1RaHtchRkCOEPBjyET5IupzBZvg5DjTm+FzCGmjj6L0i9TbctRShvSvrlGimfnSLDOjsmUtBnrZ4defXK141j1jUchxQHKN80ilzHDcJ4nuCGZKylVjMfH7v4s6Dy+/5c+lzrNMcvq6zLWdzkpK1z2yLptqxy9Cw56zVeY0fBwcHBwcHBwcHAoc1zFoWh1BNVL1k7mRTIHChxULFxYOlpI9hNVwwZCD5HA53wlb4HK7B+ltc/X03RlGuTyVmkVbliKZ+dzHVPKXWpFF4aW3P110wNECxqGCaxBcCs4TnDk3NFcPeR3nIvn2XPx+xeTnS49vl3qrxXuyeVATj46DwomZdLaj1z1XP7uWXjCvPpSByfCQfBwcHAoc0glY7QewugfULc8hqBJtkdLakOGiTqOsYmHI5HD4OBQV0o0E80mvssJ7dc+y2vQVgqwg1AwkKs+uZl8jmproGWodeeXav8+92QJIVEUGg0OFwua4TnLgVhSDViV4TK5PYPNwlYY5rfXG9R570Xk1XJjhjzbcanRSXV5zexmt/DVtQUEQJJkvg5nScCC5nA+m6xKRwmgVisbSE0JNgKjmBAGRybUcHAo+YqODh8mR6SV1ErTdZexmt+D0DP1w6ZhcR3PaKWnp+THz3R1XVwJWc00jwPtTaJ7VzFpj3VEthLRI0rnmuJUlQeK3wTds2b8U58PvHiRiOnKF0Zed73g9toqupypubSWibciZOtnl6Gffl83yXU3iLY5pW1pcCg5MiCAWhzk9N7QBNqRto2JKOmKQedNRwuGrEQiOBRuBochSnFnNnrbax7eZ28O3XsbmO8NZXslR0c9HSjOIXV4/onle/5tvgLXg6lITsHcqd6Oud1RoXovL6DIbQ5pSUISpUlzl7DPI1ZSnyanHi9o8mPJvR5vIvSy2fLXnG/RkzZUBzYoaS0zORKy3ivFJEHwKzkcHBwc1zFoWhWnAtHUcJWnjJUqNoMTGJpTw4TEMg5D21DhtBJFVKqeaSZ69dPtUGng19V6Hl9NfMlXlX9HPV3MaQAqTXydMulvF7FL1eU8TnQjF4zFRlmenfx2XMbTEOy1oJpKw5xwntPcErOQYWRxew+VxaTOfmH3ufP6zXaTfc5hZ66l2MHS2IZDSBQSToagiOTRnI4ODmcl1C0dScxaH0nBzGNNbIkQT6HN6Wa9K59fFtVBrN7HA1U1UxA8paDlZFpYT6Whn0M5t8/CcXy9v0nD2Sa5RtsoNgg4xr6yzz8yxtaHj9+t0xA4sws0ZmoFWDCXsl0DHYutCazOLuq84OTaE1Vp9ZmMpL5txzee+eDV868T9iPKexI3Gku8po8OiBVRwaqVCSIjkIjkIhyaBw+a4Oa4FZwOYSh7ktIujI5cDgCEcYpbmeiR37bh6/L9ozvV5gZQcxibYGoVNxZzptp9BVnTa+Wxktduyn3N5n0BaiW4NkekMzj645mvHOy3em6y7ajn78/wBXnWW2Gm4/TwZkLTBpnzfUjBYG17G1hybHlDAYiaYleE05PSOTy/J+7h0+Rhep+a9iri+kDLkyuxqMqAUxA5OHyEDg5HBwcjmcHBzEaUFoWkoc0oy0lHzZhSLRg4JSoWXTVGUPKGydL5NRqUY3k3s4mHPKMFplNdEvX0mXftsugKo+sVlu1mYvTxZnbLInnDGdqY72eHrZ1Nm3JdBH5fRq75G1mlLgVlkVcRuUNPjk3LWG2d5lOe6jz95z+V8t+5x6rHSn1MZsVyqNOnCRNEjiXNizuOm0GoYjk1ZwcC0nJvpOpFYVhWEoe5IN8jKckQG1EwIwAlx4oMA8jpETUODi3q31XVI3mktVSjIa3D9OQb+ncnqW6dlrnTdWFFowXlV0gBnp8hBmGQvQZ+g7k9Vu3GTXCatbnJhzrNa4MvJGztXSqZO6hoso7NyFMmefW5eHMOL5W9zK/U5K7ElVOxq0SRVwK0oEcSQbjQsdYcggbR1HI5ioexaRAcwtJW0UtAQyDUXAwYMwcNRLLQEQk02R4F0EbQGNKq5Pg4ZTa1foKaazH0fXcahbXVXVJ0ESXOzUl5UWyyFeCoCGUuzd66Nbvm9LLz0RenAtZaFZTeLuxfRK3zo85BVoXMl2uU2mc2uWRo5V3+co9dPKe/HEGl+88+aKKANyp6Oa5jiXNPadcyHNgN+TZhvH59a6dYTzE55rmIDQ5HCcMlHNKCSCTQFQoOVOA1DrTbGSmIDD4FDhqHFqqMbT32caWK6vZeT0ZVqDbrruAXe4Sf0PJ8+684LVLycRwA24fFFp3lVseH2M6at1xdeemmajD0od8zqyc1KTnivcs5OU2+WGox87zn1PnaRduS6156ElItOIwiASwOiuStcx5LhOoI0QRtINcSyLR1Jx1HlqHKgQ2zbVSKgJCAYuaRj3LbTKQ9ATQBxwjZAMaSa5HD4ORwcDi3mhTeQb89JZttcPT9d57ptSLo47uqrS7rj899Lza/GhmddnzlBCmj4TxyKNVPWnL7PXg2olUrDn7Id5qTwjGcwi3zztMsrbPl0y8LyH0+Cmrfzzo0rpdZk5NKTogJmI5VFimtGuTVLhOoe0QCVJKRHJAI5cD21EolB4CY1MQDASoAxSwRYJpkUPOmRaZjAVHByfAbPaVG0PXFztxZHoRayn03Jvd8/R7Bybwd5ka5ZnrYLus6eTMGEbnoaivfNKmpufVBuWVihLh2VabDm9Q2XXUawSpvshM7glPaIZyjOwMrjLDQT5Nhfi+S9y826NYqunZCyAZMlRP2Uct7RmlTjoEBdFIqCARp7T0n0iNPYQnkczg4OAbpiBoYUOWJDEwyxTTIpibYpc7G44OT5HBZYdenyrFdODrohsWd7J9kmsqlYez83tae86q6pdNa912uVFvxxcatMLhdPLnssJe+Ol2Nb4v0/mHb47HkxwSix2PR+T081l6BNOMrrWcjgrqkqYFIimeY6CPPn6fJra8u7J8j26rqDJZ1IaiQ2Zi2rHdBlo0e4PSdNR5uEnJ0zm3LxOJJQ5p4ijVpokHwNG0TE2jHNDTGgUsSY4pqEVNy0984fR8C7fLYPkOT4rk1KcqM9nrS6XosrkpNPPuMvX9p5fZFrlX7xDeldRb3jldOV2NWXby5g5/wD/xAAlEAACAgICAwEAAwEBAQAAAAABAgMEAAUREgYQEyAUFTBAFgf/2gAIAQEAAQIB6Eletay3oDxjQ7WmBx7H4HoeuAOOKZ3M3HXr168BeoXqECdbC9evXqE69OnURdOvXp0qRMvXjjjjhhnHGVQ/+cWT+xIY+hUrxx6qbGzZgzceh749DAOAOAOAAM4A69QoQIECdAgj6TJ8/n8/l8vl8vn8wvToU6dYiV4K9evXggJ149cZx/hHlj8GIxshVl44/PHvjqBwMGAAAAAABQoQIECBAgjEYjEQi+RiWiuqGmGj/ov6I6ZtYahh+Xy+Xz+YXp069evHBWB3zjjjjjj/AAiyxgHpvELnjU0DoVII4/0ACheAAAAoUL0CBBGI1iWCDVw+Lx+Ix+NpQ+n87+x/sf7L+z/s/wC2/uf7f+aQdY3jcni02iet8zGYynTp0K8cEV4bUWccZxxwB7jyz+Jt/D5XFv8AbaZlYEZxxgHHHA9DAAAAAAAoUIECBBGIxFDUqeMweLQ0zZaw1gzmXv3Ll+/0+nf6mb7fyP5X8xNnFvl3Zhk8asaCSuYynQp0KleCOvHHHHHEFUjjIhZ/DyF+/j282uvKsnTr1oa9o+vXrxx1C9QoVIwoQIEEaxJFFXp+O1/G0yS21kzGX7GXnkuZfqZfqZTJzz3557dzJ9fuJ1uQeQR719Pb0ZhaORSnToY+nXr169eA3HHWNZx16dSfQPdsPvnv9Oe4cMGBDAgjKdhyMUKqx1NbU8WgrNZeZpCTjHtyT2JJOHCT6557Z0Pvt279+/cMlqn5Gt2z49NVYYfxzyuWoDnPfv2Uytz+vEjKpXr069OvXr14AAAAChFVVVEhp6ml4yuNM0zSmXuZDL9C5bsXL9i5ct3+v0L91ms7VpPp37c8k8+u31EtTawb6bSTVDEydepHGHOOM44AkzjOPYzxETnOPQ/AwAAAAIFCKixUtbQ8bXGnaZpS5PcsfRw+jhdpWk7c89+/PPvn0T37ei/POc4Gq7Ot5DZ00sDRlCvHBHHHGV8mLDjjj2M16N+uOOAAAAFWtDdopFBV13jMaNM0rOXJJ9clvoZGlMnZmJ57M/07c8YX+v179+3OclyfxyfXIejtqu4vaZoihXghk444444469fQGj1G/wBicP4GcYAAAFVFRFC63T0tY8rSM5kLlufRZpTnPbkkthJdjzgHDO0mc9ucqttZOwPPrnOexJOc89tV5QsFim0ZUr14444AXGymJR8oqVPxnY7gnn8AAEAKoCqqJHDBqvHRjSFyxJw+ixdpDhwnC2EnCWwjjr1xpPR/R9D127dufTHsG0ulmzt2r29Z5JsdQ0ZTr169eOvUR/PoPJH8o1sGzoH8jBgGBVVUVEjpa/WaV5HlL/QuWJJ7MxJYkkv6LFs5zjOTIXzn8c88+gWP45GP7WwWwYCDpPKZtfJEUKdevQIsawLS/rgeYLdi56VMAAAUBQiqiJHq9NVqSSszMTzh9En0SSWzjHb0ffJ9cZ1Izn8c88g/rk+4YJ634GazbV57FUp16BFStW1Xi9rbSeYc8++PQAACqFVUSOPT6EY0rP3JPo+jhJPPJ/Dn0c5zjr068dOpXr1I4P5XD+2POabZ7faex6GVrOs21ykV69Y18Yobrc3bzT5z6GVrrYAAAAiqqpFHpNEWeRpOc5JLdzIZO5b0cLfRZZ5sPrjr06dPn8/n06lShQp1IzjFw/s/5jI5NLv72v6gRBJtjbmkJHsYMGDBihQqoqIkWk0bSM5OFueSxcsT7JLl/wAcdenzhpReOx+KDxv/AM9/5w+LP4nL4vNpniKlSpUqRwoPrj3wf8wcRtBv7+v6x5bsWnk9DB6AGDAAFACLGkSaLSyOzs5c5ySXLc8k89u5P4A6LHDWq+NfKz5jY82l8pbff3Q3SeQQeV1/NYPLWsNrLvjrxlSpUhQRx6et6P8AoMGK3j2/v0VwtZx/zQtSMAoUKEVFjj0Omd2kJOckliSecL9i/PPoYECBIa1Px23uNp5hY2RlLdvx2EizwbKDySO7U3drxq7o2TqFII4x584P+I9jBgxH0G6tVebFean/ABh6GAAKFChVRI00mo5dmJOEliSSeSS5Yn2EADKNfqlj3nlNzYl/3x+A1DZWp9Z5MlyG/sdLwykEcccYf9VweopNRsp4jjHBgwAABQqqqLEmq1qI7s+FiSSSSSWfthOcgKvXqqanUTz77f2LBOAZxxx149H3zyrmWlfuwajyd9Y6FSpHHGHOP9AcGVbFezIjehlGhNXGDFChFjWnVp1ZJDhwsSSWJLFvRwNzgUZVNrYgajXs3kO3sz+gAOOOucls79ufwrc0rmzgobFMnrdSpHUghICvH7VPQwYMXNfsJsZeBlO1YtAKFVFRYk0mullJJOHGJJxn9E8n0MGGTsMGQJRrbi5tLR9AAAAAYztLEv8AUf1v8T+AdTJD7BI1sgNO3q7+28UeIqVII1+yfOOv+IwYMXGOg3O0qcALgAChFRY08e1k0jE5z2ZzhLHCSSeT65ZhigZSpa7S2JN/uJ4uAFAHHAZ8+cdo3nHTrxibAoy+kNJ7OKdXsIvIiNjpCpUgjjiKVv8AIelx8GeL7i5rxgAC4oQIuuppGxLE4c4OOcbGdmwH8IgTWa3ebinLdt7a32YqqgeuBGUEXTrwwOE4MirS67rFHCs+ii148Xta4TQWNJ5hb8Rua4qQV444/A9J6ii4xck9A6ry8ABQoVUWNNFQkcvnWGidYadirwxZ/wBhVFatxNcvX7WxmkRQiYEwCnqbkHz4OEMWZiTwEhq1ptrVwZQv7KsrUfJEpT1gfGPJbVraePshUjjjj9g+lyTB7CqoChBEukoTFmwZTreR+b3d0LOr8whvu2H3z7AC+PUvN9tqn2s9m4gjRUaNs4rj+asTAg4zuWJKIkcU0q/atfuQ+tNf2VMZA6GSKM6azT8is0JIipBHHHHHHHGcdOiJKnUDBKsiurI0OaqpI/OQr5lt5ZfXOi2LqQW/AwKq06Mp8guax98e0WQAIQVQVo010sEmSSyStKZO2DARKLDPkkmA5ffEyra8kxDrbXksWt2d2jIGYnt27du3fv8ARKv11lWZJ859KEEEUlBF0FCdySwyof8A6FOfxHlWRmBztgRUSPXaKtY8u2M8laS5gyLIEFZ4mwZoINluW2c1qSRm+bD1z6h06wRbYeQx7GWha8a74p7RyJiw7EI/iGz32sZSCOOPzzwjxmx6GdVxBq59nuY11laRjnPeB/8A6FB7GVouOevUKFCJFpNd5Z5j/wDPbvndnls1NplrNRSFLMFhq7RhKdgPXatzLPnHGKsMjPnTjiK3W3VmCemyDK7jIptcc1+XL+28cZSCOCPzzkJuehnKBAgTNDTnkYlywz6bijZrcAKmh1LDjBipDBT8emu3PNJt/Wh21jnrE00teKhNBd31qZ9TQl1deK5FPcmSaBifSqtZYxFxh/BynclhSSSqZMB0l3Zx6ZtANVtNlVZSCP8ACLL34XECiMaSs5Zz679/tepzeKp4vU0jy/TuCuVa9Khv/KLu2L89jaIiZgqwTRQxXHsjPGak0N2a5Ks7ysScirIgTjq2ENhJbnONFL5BHqnt18XIDuc1R8KfSWNVb32rZSCOOPR9xZd9D0oQIusqTGR+SxZnL9u3bsWJ5xQg0dDyfe27foAIEtU1JWsYao00t+SXURSbh9nPUnrTQPhxUhqhVTjDjF5ScSt/H4GQZvshbcpiZCLsmubxSSSXyoa6fbadkIKccfiLLvoelCBB4xVmkbCSxLP255J5SFmModTqK25u7m72t69cVVXpC+w19d3p6TYum8rPJBLql/k687nb2pSBDWS1ZSIj1JIzgfx2svPg9QNv5AVXFzv2rZ45akbyTPDLNCzuKHDSMOPxFl30PSiMRrBDIzMSWZmbnnlm7/cuMXFzxePya/KkkHWJ0Too+EM1qvqxc8XrbWezKFz+XrsmgOukpyTpHHFFWZWVmkmTFia27+hgw5Qh2V0ZBJi4xGRGGcncNo7fm8U+Mpw5x+I8vfhMjGkrWXkZmZyzMT65Zu3IGArnjz7Z5GWxRsWFqTiZVgFyDYmGTReQbrC75DJClF2sWPK5J4tTJXgrOkkk8kkxPSefgYVweoYJ50FdLUwwn0uc5s3rv5EfD5tvpGBBzjj1Hl70PSCLPGIJncsWJJOHOSWJOd+RinQvtyCTGYmkWjaiSELRsGLXy67+bNhZcgt/d7Gv0Gv0+wv1qDxtlixLKqF2zgYUXCECRTzKqIMml/A9DL8kOSS+NS7HZbrVkeiD6jy9+EEQqRzOzOxLNz7OSx85TgYBg+lueTg4MIT0q6q3EYNr5NV8c3tme9SsRnNXVs0VrVJYd+09OlPFevWb8ksFSSSOl/BeAgZIuQ59FxFLySex7GJlmRM+mom36alyCMOH1Hl78JmsgtmdmY4z84Sz8nCeeQeQVxc3tY5CrZDElaSrE0FnVT2o5tU1Zo4dRLlWTWRrS+MeurU7N+/t57hetrotVDoZK0yTLIJBJgxXCkCSG5X3i25KF/xvEDEYM5GWJa8kJ8Su7+mQfZxMvfhB4zDckkOSOSAcOHJkLE5yHxcXPEYt55Ts8rBRAKccNPa+Ol6lqpsl2Mglls2nxGqT1LNSO1ftbya7JZgrQx6nRVqmyrWEsJOJMf3Gn0+UdIEbCHyGHzeidjqnGL6XBl91OjfTNtac8JzgjEy9+EzxhLDuSZGGdmPZmZm/IwYDQ2O+ksw08TK+URTGvXybxG1VjsRW/wCd97EoxDC6WBsXumbIKH8nR6moEy8tpLQsZJkwUNkKdqi28aTkw9Uk1u32mt6n2MtSDNA+ufdnbYfRw4mXvxHmuSZ2LM+MYlsa3+xY436XKVIaDYzXI6WMK5otVOuktHcUb2qZe/fFbgEShwgqfzFzXQVDWkhksNca2bBYXjWTgyQU0qPrRj3TNJLzC9afY1gV/OmkrHZZ4XNLGQRiZe/ECyZIXZiSx+1rymJq9k4w79+RgZc1M9x7Nb+Uqb2CB6MlWSnPas2pNklglj6EZbORZN8vHlY1HrSV5Us2pbUltpD82NlualOLbvuJqaPJVZGDZDuNHsvJKuyq4Mb0MqPFlw6Gx5PBhwhcu+gBHr0vvIxx2YkublYZrx2kOD0MGKNdrPJ7UshfpHkZqz1bNex/MsWrk8+TKqqupv39pPOR64QwyVp6liCcWZ7U9iy3azNTgnlrR1dKsD7nkXrF5pHn+xZc0V6GORBhODC8OX2pN5FhGHEy5611RRo02LMXZicYnJFNRE4kzkehi54xrtrs7t5VStZqK86RyV7cWw/sXu97exZLNelHWiv1LM7yPXIXAoMU8FyLZf2smymuG7JZrau9djEFM6MxU9dLjTyWS/HGV2rP5Pm5hY4PUjVRtXr5UoWIDnCi36rWGu+NreLFixYnCT64Zm9D0uINcN3tWZYBroBauyRd21YsC2bhHeOnXWKvX8dt6Jactx25GKUcUnQWP5P8j6waiM3L1HU6zxy1aq6uxYu3ZJmlLA9icGK/kxsexg9a1d48GaiXcoRi5a9P68YFwyOckdmw+3YY2cllwZqKvkvkgZTBNQv16Vilf8asU0b+7N8z9OnjF+ZPki/1v8BK/wDXNTbOilRHObwti4N1JZ/i1qBqmxG9rbSW5LJbAAnQoi8QZ5K1cYPQ9azPJHhzQHxC3arHFyyPfjGXCcllPskYzHAatCxAAMGfdsGDOyGtku2/s32skKxzRHP4niNTyPWiSpHrJ9ruJ5n28kssBiYRUZIIokeGmumi0VXW16v8mfbXtlNO0hbAnPZWw5FhyjFvJ6f61MW2sQZ49mss+XQnBln8eM5byViDhwkYSfdPZWLODBm1Net88CCWKxVaq8cIe+kGpra7fNrr3kuwSvBo6Wnv+MWK0Mli1NNGsaz7SDafA31yDGb+XNs2uyXntGTlVAIxcaOIzKmKNWCVwfhBUbIM0GRHcg4MsfjxnLuNhw4cPvjCWPoZzbyt6ODBhRJor8e3O0WSxsu9C3ZdGrrDYgmh0t6O1elsqpneVImhimeUE4LCbN9m0/OLDhlVwWUAYuWMhysm8lgFxufddb75DmtIyDDgybOPXjZvY2Nhw+ucJzkn0DlDIomyJfSy4YVYTdGlLRyCU5XtxWhfn3M1uSQpGrv1OA4D2D/Tp8/lx9Wk9Li+gBiZYZM1i2Jo83De1WHJpcjDEZoEnr5L+PHzsQ2NjH8HGON7GDIJQknrjB6WQWf5HRUMTOGYri4Jfpg9SSsK9eSKjCK1xf4tJXiuR+lAHtAmNiCQwjmCG3ZU6uOzL7QM+DEG6lzxc79CJPR9aR9qGxifTFDam5wY34Gc0GkWPOAFx09DOLCwRGqa0UU1WN/sypkldTU1xryV9s2kRo7r0Gkaidi+DI0c+kQKAAZBjNXq2LAyNLre41aT0uU038654udHl6m+EcZqm3GOSCWbCGPIzhh6557RS7WCNyHGDChGK/Oll1mSVxU3Gu8d1+x1Ox0fimr2Wt8coXYPHVsLPL44bznKlxQjMwVIWfjoq9/sJWYYsarNbCqtWOab0qs3sHTLM6DQnxEwGT1qLVlqrbdTjOXZ+Wl5wYpuSli3sYDZrRTNhX0RxxBPrbNab6bezebw+XeGyPEx5Va8Oj8tv+JWfJrlSr45NtrnqJgFg+nU5zWpVq4p67JM+kAsJgCiCC5bwYAW/Cickw5Vfwp9Na2MBznIjcMmNjE4xweueSfwMHp7E1UH69+/0DfIqMU07m4dd1otj5o67bw/Y+TbnxC/ctJly7qYZx6AhrMRnTiGksayRyNZSYvkYkYZGscVm16ALeh7oR2J8rC/L4aZn3n4jyAzA4/pvQ9E5z+RgyKAgvVoVINhk8MtVAAUIK2MuU91RmuRbJJfHqRbL9ZR02K11CQxSzw1cjpqJrT3XmDYF4SB1REjM8kuD1z+VWXCRlVN/N4iGe2T7TNK1oMTje+GPo/oYMhk2lRlmaGZ5toqzKGzghE3cG9q2anoR+GR72GJN5BRivWLjxIFURVmwStcZjHhyKvFqxUaSXYRxMzTe+fwF9QAti5rktS0DGThwjEOgl2aPjYR6L4MJ9j8Aqa9i5U1M9iFsrSvGJFxBerQl5kbxu7vamWa3jueRDTJtJhk01qISrXSKWcyiM52wLFjbAyBUp8Na/xCE5GjvgyLIjAN68WJhw5wmUZd4knrhiz+xjex+BgwYrz0w81XGZMGKpC4sursa+x5LEi7fK8myfXC1PklKnBexMksANjP6jqzJ0RFsHZS2/8AAKF6scVc5wZEtuTSVvIrcGKDhHRcQ2slyLLjvh9D9HBgwYMGDAQWQsstzRcJLr5Ne0ledVHezIalqf8AkpDejls1xNPaDxSzYrBfn2S4+xZ8H+YxEypp5FAwn0Mr5IdWztXEmcehiZqmsRn037aL8D0MGDBi4MbUU7816fVZZkmxK1lpYzNXhMkkWnEkMCW31mWJ+VyPEjNppP8AgjgabBfOBS3uCOaWtHvZcqJcw4c4V0Otn3EDYxOcfkycj2MHoYMGDFynZ29N469mWRW39TXD4apbkGmy3FeGrzZT62KzY+rmR0iWWSb/AD4/CR8NL7VGb2AWzTRWJlGphtMfXC4pQ28kxs68H8H/AAHoYMGLi5Wn3/j00WaKPyyaKXWLrs8lq6TNtWIqLsJaeKC0kkMEsv8AkB1598KPt17YMCs/oelOQRbSbEGtFpSPS4uKdVJbi9H/AGGDBgxcXNLB5fstxqc8SbyGSSTVZzvM0+PlnJTYMUkBlkrV7lr/AB652/PITtnKxF/Y/NIOwyrFspbGH3FSGKa0u5hPo/7DBgwYMTPHM/8AoMVDb7LVamfY1ftqXdtjNTInrZeu/bvLkUVubj1xxx1457fpV6BuvKx4z/4a+rdsYo1kP0s5x6WcFSprGVMPuQ/4AcAYMGDEGnO7S7FVvTtu8KU53llsxSTQGq4znq03Oc88/wCIHQHnr9Fi6NN+eM4yrWuWucqQ7OfWx2PQHAwYuLlOXcVzh9H8cfjgYPQwBcXEHh9Hya55DTcZzbnrv3ERmL95ZOscNqX/AGHoZz2WDGn684FOD2BlavYseo1qY8mqibOPXQYMUgpkicccccccdevXrwB1ChQAFCDxGDbpT2V6tnjpieNosabuzu6ieGzZ/wCFY+n2L8dsWL18y2BchgmtehlSG9ZhWonHEcP9bFuJNeVGAwTbCM+uec5/Q9D0MGII81cOk2fkWsisSQ+PXL9ZjIZHLdyaME83+wQRcfUye1QQ9+REZeQmcrHLY9DIY5Z8oxKnWONpXkV6tmf0CChkjzjjOK5b9cAAAAIIxTXyWrUnt1gxO1Pbksz86+lfu8+uvXp169OnTrnb6d+fwM7fVYfg0xKxYWCfT8Ro8uKNVAc+EecNc69ak9gDBimdM44/HHHGcAAADFChBPb8orFjLZrZoXI7dso1drb/AOZYlr8vYCiHs0gwHkL7AL+q6a6CotnZGSeXlJTJCxiXVyVAQZo+OOOv54GAAAAKEGso36da9ciOR7W7qIJt1F6URH/j4EapyuGFa3RpzJ7Cdv2gpxIJZJHONnGJIHWaO3X27IRjpgxm44zgZwAAAAoVYk0dCy/kEck0mMtW88tEMMpw7mf/AIRihYliCjDM1prHrkR9DJ/goRdTXbGaKOeb1xxzgZXrT2DycK8cccccAYAMACgIuhoTyxW9tXcNMy+ke561WO3+3AjEQQP9v5Bs58M7hfj2Mn+KgCnBcmzl3bOFrFcGKJIFyMpnx4ZeOOOOOOAoAAAChF8Qr7ax5FJvXuoc7e6008UTdsC/H49P8BnOLCuu/rf4xb+Rn8f5/Uzf5qBkaQiq3ohguff43aHqKVsXEtR7GPZWK3HHHHHHAAAWhLO6pEmhj82yGdZHkkwj8I4f8BhMJ/t9e/YN3EonFhbBk/j/AMXp9TbM+Ee+PYHHsAFEpVbtzWREBFoz2WbmvOJHj4AVkvOCFelaYccdevXrwFChVVE1WrWCazRv7arawnuU/Am/0Gdvp3789RAIOnP07Z0459BePY9cxRVq169EutgOVnsTkt6U6uzso+BjZwjOcRoMWRMl1RTr06BeoRUgiRJG2k2xzXbS7VmLpzzx/wAXIf6/XvgjEAr/AC0+mm/+eWqYiEXX1z7Ahr82bYyjBBEQxOK1ixwMgZ45K3SCq2jeEekxpQ9eWG/YHWKK5rugRURPHKkFyC15GEmmWKxJZnj6cf8ACAIhB/HFcRZ9jZNjusNabReZbS0c79+3PoBY4673uciSonU4wKsPmQQhr3Ke42lLK1qwjJwBgxTE8MkdVq0jBAgRE0CaC1rL20mnAkPqOyY2zgp/uPXOACH5BBEIxnatsJbzTFvfHAUL/IZ/SrBHqY+vT+tkq1KVjcNakxPUMgmIGUbFxOnXoEAhj+CS1NjZrKgQJ/EVHt+RS7R5Gb1z6WXr8O3HT88dPkIRCIhGIxGEw2AeAxkMhm+pfn8gZ39jFFeLnWVusD2rrTPYPo4uAqaj8dUAEGvtaMpwAMrWpEjxHVAqJSjuT7pNdYp3JFcBuPwCJP45osPXHGc/QSiYSrJ9Pt/I+iyds7ls69OM5wL8s7fgYoiiZ9LQjeeWR5DxU1ljTNWQSBPVe1X3S1pocV6Gz2NPjjhcSSJFSDPhFFuJ9vf8iirWdmiSnGUP+BgwYjJa6/1R0T68r279ufYzuJfsZu2du3QQir/H4+pk/QxVUFqdajVbGyTGzWa3YbuS19VJwegQ1S1PJxi5rrNiLr16pkZhlUQmnF5VN5k96WTKduxEr40YIb0PQwYMXAyTx7D+f3NT+p/of/Nf+XPjX/nf/P8A9D/R/wBN/Vfwfl9DZNozf4AcBQ2QRUMbGHzkq9ZrMzn8KDgwZEak/PqFpz069QEEWIsC0X8uML1rNlMimaNXGFTEGVx7GDBg9AhuwYMGD/X6/X6mUuXLE/5RpPTAAwAZFE0mgikiWOS9PsIIrllz6GIgqleoAyGWvlrXYiQ0pqfAQKix5EIomzyvKOw3NVZXTEcEgOCVMWCUEYPQwYMHoeueeeeec55JJ/HX80rW/wDIuRgAEFaS1GPGoprf8qSQtJZeTIde6DFlo7DZVPa5C2omkHZG10ohnr4MjyqryO8pkyMtiYfQyAsMU4MljyNx6GDBgwex+zh9H2cGQVT+x6GUor04yoKYkLFsbEpTrTXYTHP/xABREAABAwICBgUIBwUHAwMCBwEBAAIDBBESIQUQEzFBUSAiMmFxFCMwM0JSgZEGJENiobHBFUBTctElY4KSouHwRHPxNFSyFiYHVWR0g6PD4v/aAAgBAQADPwFOLMN8tewxdUOxC2az1Zqm0rBMJHDGGoUVY6MG7dfmPj6djKpjn5tBzVJUSh9NCIxb03Wb/L6Iu3C9uld7ncgusfSRl52hsEMZw7uHo/ODxXnna3Dj6GWl7Dvkn1Ly9+9N2gxZhULsD6Jjo8usCvMfH9zPo82+HoiN3SLMVuKuT0jqJW/0/nWrzzujv9ESvMDpH91Otzt2amfuiefgqs/9PJ8lXf8Atnqv/wDbOVf/AO3cq/8A9u5Vw/6Z6qm74H/JSt3xuHwRHD0+wkxWB8VdxPp/Ohefd0Knm1VsAJ2Nx3IxkhwIPf6LzHSOofuF1Uy9iB7vgq2Te1rPFfxqj5BaOZ23uetGQboGlUsfYhamjcxoR7k7mE/mn809PCPIJnGMFUb+3Tt+S0TL2oAPgtES7jhKpX+rqVN9nI16rYfsbjuUkd8cZb8EPR7eUMuG35oQzlgIPh6TzoXn3dCsJ9e9aSgOVQSOTlozTHmNJQCnlP2zN3xCl0ccXbhd2XjMFb/QfV/QD0skpsxjnnuCrZt7RGO9U0fr5i/8FQU3q4W/JAdgAJx4oolE8emVzKCPBW4rv1O4Gymb7Z+anbvzVPL66Brloar/ALoqGX/01S0qsg+zxDuT2Gz2keI9ARuV/QbZpOINtzW9ZavOt8V593SKaGHRlf16SXsk/ZnmnaOrXwnNu9p5jWUUU+vlLGENIHFOY4tO8GyPkvxRR1FFHoE9LLUXmzW3PIBVk+Zbs2/eVJBnM7aFU9M20UTWjwR56u9HmigUNVuOsILf0wggEFbnqezc8j4qqg+0uO9UlX1aumae8LR1bnST4HcnKqpb3ZdvMIt3jUzZWbv6B9A4Nwhxty1FFecHijt3Ioo9Cx8F+1vosJDnPSZfDV3ooo804HIpzjcnNHyS/eiiiijr7gu5CncSWB10HvLgLXQ5Iau5T1R81Hi71brVMn+EKko22iiaO9HciidZR1lFH0JTrXI6LUE1NQPBAFOYcjZVVP1T5xnIrROkh55mxejg2lNIJm9yfE4h7CPHpHXd1ioomNLH4iUUUUUUcQR2rtR6WMVcB3PiKs9w1d6KKKPJHyL46ij6K6qKo+bYSOfBRRdapdj7uCjgZhjaGjwR1nVZHoBBckUea70OesIIIAqifotsDKdrZR7fSKKKK71bjqKqKQ3jkIVLWjZ10P8AjCjnYZaJ4kHJPicQ9pCt0yelmvOO9Baaok4NjKvK49/T+p/4kUfRT1T7RMv3qKEY6l2M8uCjhbhjaGgaiiVdBDoFHWBqKOoooIegKKOoBFd/Q713qaldiilLSqWtbsq+MX99CSPbUbxKxOYSHCx9HGJfObkwzEsFm8F1j6D9mfRaeofk+o6rVn0/qf8Ai6bZJWtcbAm10KaXCx4eOYRT5XYWNJPcF7dUcvdUVLHgiYGgIq/HWUegAhnmu/XzPRHRsgEOmNZ59E9CqoH4oZPgqDTLdnVNEM/vKWn67evHwcERfo23elfpGq92JvWe88AmVU7YKfKngGBn9fQfVPj6BzlNWHs4Wc1BQR9Vt3c+kdYCAW+yJQCHT7+iGonWUdUflDdr2b5qm2v1RtmEI+lyRG4qpoPNyefh90qg03FtaCQMm4xlSQSFkjMJCt6EA5i6u42VPs3bW1+9ecOEZJ/ulTymzWEnwTmM29fI2nh+9xTBT+Q6Pbsqf2jxf4+h+q9K6L3WaLlbpan/ACpsLcLBYdAIIIIILkigEdXLo3Q6FlwH7nkNdPpGhmlfPgezcFs5HMBuAba5qWXaQvLHDkqXS0QpNKAMl9mVS0nWHXiO549LTf8A5TB+Km+wghg/lbmpvpDW4J6ot8U/R9S6J2djv9CfJx4rLoyVcuCNl1FQtxPs6TmrZdEdAdInoHogIInpjpb/AEUkYIa8gInf0Z6AbCp8/S8jwVPpCnNXo1+NntM4hEXBFj07op7+yxx+Cm/gu+WuSmfijdhPMKSoze6/jrBaeB6XmB46stc1a6/Zj5qGhhwxt1E+gPRPTCC5Lv6IQQR9CB0stck78EbS48gpKZ+GRpae/pVOi6kSwP8AEc1RfSWDHB5qsHaZzT4JCx7cJHSMrw1ouSooohNW/JUOj2YII2Zdy65yj+XSPT8zrunT2mqBhj4DmmQxhrBYBE6gmoc0UUdeXTI39AdEdA/uWQ1nRlXtgjpOtdMRa/TlpZhLC8scOIVL9JKUQVdoq1vZf7ylpJjHI2x6GaZ1qqQdVi3tBwhOffNHF0qZuj5IJKZr3nsv5IE5dLzQGq6+3qW+DUGNsNV+hy1HWUeiFgfdbd+I9C6tqKKOoo+jz9Bl6R8Tw5hs4HIqDTMAoNJZTjsSqWimLX7uB19ZCk0BEwZF+9Y3lXKz15eiyCvuVgJ6j4BANsOj3+hCCKPPVfpTz+rhe7wCrpN7Ws+Kd9pUW8AqNnbqj8wtGfx/9a0cd05/zhUx7FS9O9ip+YVaOxs3/FV0Hbp3/DNOabEW8fQZrPp5ejFtRY4EGxCh0jTDRukjn9nIn0cxBzbwdq6yvo6C3AWWZ6OXouqrfWJx4BACw3K6tqPH0I56ggh0pJ34Yo3PPcFM/rTvwDkFoXRg849rnd+ZVLB1YIwql3YdZVb/ALQqoPtqb3yph7ZU7N0rvmqtn27lL9pheqaXfeM/NU9cz/0sNWP7s2f8itE1ryynqDTTfw5RZV1Jns8bObEWGzmkHv6Oaz6EjIWyFvVduOvL0xBuN6i0hANGaTOf2cpUlFOWP3cCs1jpXM4jcrOI6TaSo2jomyj3XJr5C5otfO3oNpaeYdUdkIMbhG7WOgekejfoPmfgjYXHuQtjqj8OCoNExYIWtuFPNiDHWHcpp3dZ5Tijz1nWRxThxTxxU0Ju2RzSORUVYzY6Wh27eErMpG/FaV0XD5To2r/aVBxBzLPEcFoXTowVDBTTnnuUIzbMIgeyXZtPxVbRDFJFdnvszavh05HxCMuu1u4a8vTWRY4EGxHFR6YpP2bXu86PVvUlJUGKQWIVk2cE7nKRvBSe76Q1Uu0k9U38U2JmBmQCudV1b0xQbrkq8z1Y+apdF0/ALeyMqWoeS5yJ9NU0E20p5ixw/FUml/OxwtpKz2sHYeqzR16aq8/T+1G9VNLA6v0HOZ6T7WkfngWhNPjC8fs+r/0qqoM3txxHsyszbqz/AHQDerqxTo3hzTYjcVF9IKHySoIbWxjqP95PglMbxZw3q3pXVs+H2B2imUsAiYLWGrkues6u/oDXz1EqwRtdE6nTkSS9jgFFQU/gE6R7mhydK4kn9xsbptUwCTtjc9VGjaraQvLXD8VBpWmOkKFuCZuc8I/+QVVo3zUv1mld2o3ql0pS+W6HfiHtw+0xEEgjP91sr6pKadskbsLwciovpJo3G3KtiHWHvItJaRYjoT18uCFuIp9O8teM+m+pnbEwXJUej6QMYM+Kv0e/omyK5a764/KYxKepiAKpDFJTCBgG4OQ4I1U2IjqBMpYMsrBdpoKMjzn+5kLGFJQ1LZozmN/eowWVlN6ifh7ruIVToyqbPSyFrhv71SfSmkM9LaKvb6yL3u8J8LsL226b5OyCVb0LNmSXZ9ObRtaJ4XWIOah01QftCk7dvOs5dCWkkxxOLT3J9S/E/f0uAQo6fav9Y4K/RGoBcundBAd6J1X3oyyBg4ptJShoy5rZxFGWZ2fFZ+gAQUkjrMidIeTRdaQeMToNkPvkN/NMZ62tgae4lyouNf8A/wBRVIexpGP/ABMIU32T4Z/5HqSI2kYWHvHRuMS29NNRP+0HV/mRBseCmoaplRTvLHtK0X9JNHOfVMaycetKlphtaV22jKcxxa9pBG8Hh0TQRyhrQdplmsTydRO4elzXXKfoitxfYuye1R4G1dN1qeXMei20u3kHUbuXAbunYK659PkssuhPWPwxMvzKjpOu845Vs4XHcLKGQmOF+0d9wXVS84thJbwRBzBB7+kwdpPcSLH8lHbrOc48gLBSRNtFFE3/AAAqtcLeUPaPu5J7zdziT3nUEFbcbKYNwvIlZ7r81BP6rzUnuncfBEGxFj0DHUtPevrUtt2I6pNF1zZ2Zt9tvBw5Ko+j9bgYTPo2cY4w/wB3l8Foj6T0+KlkbDU8iqzRzvPRHD7w6eyvkCe9XJPpOsuudTWf2dVm8EvZ+6pKapczBjG8EcR6B1ZUthHxTaWmETMgAs9ZK5arawgEeldFWUlfPhGTPacoPo1o/YwNvNbIKWKBjJ33fDGNs775zK/akD3B5ZSN5b5D/RNY4xQ2Y37qefad809zLOOPvO9HBi4dAu8EzPCz/E7giWhg4ceatrHFDotl7Dx+qmwXAx2RBsVTD18j/BjVoN2Ujq2P73VP4KSKHyugmbX07d5Zk5n8wT6qY3mgivn13qskZ5iekn7mSqsoDhq6eSLxGXzXlOg3wO7cDtoz8inxPDmPLSOIW0aKLS/nYjkH8Qqapi21DPvzF+KqaGUsnjLT6UX6yzRldYKxtq6wXnHaiMwbFNi0cyOp6z2ZX9B5JSbZ/beiSdR1STC4FhzKijHnZgPFU59XUscfFPh7W7mNy3oNV76gh0N+t9TO2Nm8lQ6G0YXbsATtMfSqm2pu107fldH/AOm5nh3XrKjDfuv/AECbFoctZkAMLR3LayuOsMOCT1Lj/lRY9zTvGouNgLkp0rCcOI+NgFsJjCXNeR7nBW7vQFcwg/c6yfSnru2jeaZNepgt962ufR1SJqaQtcPxUFdR/tShYGC/1iEfZu5juRabg2Kr6QbN8m3h4skzWjtN0z36NIpast60B7LlNRzuhnYWPbvB1OoH+SVZx0j/APQo8baSvYKiCceZl97u8Vgi8qoXbem/FvpiNxtr6wXnXdIooolGrrRcdRmZQY3CNwVzrBG1k7ITaK9NQZv4uVdWvLpqmR3+JTNN2yvB5hy0jQHDK/yqHix6ptIUfldI67D2mcWek2cRqXjM9ldXyVpR/bcT/dufwK2egdHs7g/8EZKdrbq514mOCu8u5oncmQNJJ6x3n+iqJqZsDPNwjlvKAzKOu2q3HUXlEHsX+KaztQ5eCif1oTgPcngEE4kWdU7u9CKQOZ6t+Y1ijrLSdaCUYJW82leQV8sG8NPVPMcNRjkD4ZCx43KL6S0Xk89maRiHUf76fBK6KRpa9psQeCsc1+2NCy6Jlf56MY6dyqKSXa+2DhnYfa71S6cpjWaNsycduH+icxxa4WI3g8PTFHGvOuXeggvuhfdC+6F90Ie6FiIAbmckKGg++VfXjeAv2XojYRHzkqMjySd/QfQV4z82/qvHcusbbtXJA62801BBS1LxhYcF96bSUBtkGtRqa+Q33FYa/L3T+RV9EaMf71O38ETlrGJ2S6i4IMbiKfVS9VpPcFJHCMdmLBzIVkLHp96cOKcMjmg43tmuNwmyUQB7TDl0PKtG0lT7bBsXfDdrfBM17XWc03CZVQ02ko/thhf/ADDU+jq4pmHrRm4Qp9MCrh9TVs2o/VTaOqmSQu3bvDkodP0A0hRAbe3WZzRa4gtsRwPoCiiidHGpMjcvZ1CtkcHTbMAcVsa4xB2IA7+aO3cj0TL1Wi5U1N62Mtv3avKavGewxcBuGq+obdo70XaYwe6Oj1lj0fA47ywK6CGolDUSbDMlBrNrV/5FTy1rqeItLom3d3LyShwD2ltHuPetlWMcd181tvobSe9Rzuhcutv1u2OO2V7LzF7ZXXWKdUSiNv8A4VPD1XGzPafxK0Jo3+/fbsjNOqpW3gDWff496pHX8zfwcqQ3827/ADKHhEPiV1cQYMJ48PmgEOjUyMEkuCmi9+Y4f/K0NT9uWetfyjbgaoKY/VNEwR98hLlUn7GiH/8AEmT+uo9GSeIwfotEVLMUtBNSf3tO7GxTCLbUEzK+H7nbHwQ8jnhF7BwcAd+q2rbfReeM/ZSNeF1l1ccefcjV/ROnl9qmfb4LqW+K2FRsJD5uXqptXt5YhargzkZ77fe9GcNr5ai3cUXTgnPNfWHazrFNUh5F7FQ1tKGhqxOFkKDRwHtu3q5V0BqwvBVtKMn9iVvRMkrWjeStlCyMew0N1d+s6io42eVTW+73LYYqOgf1/aePZVjpSWZ3sB62tBQzt3TN1WK8qhq6CT/q2Ym/9xv+yIPeMigfFY5Wi10+eknjZG1zBhf3tQGhh74kN1humwML3/HvVbpHPFsIFDAzqR3d77synf1UrtwTx2svFBjQ4WJvkpZyDLI6SwsLncj0MSdTZxMax/8AEdm74BOmfjlLpn+883TuBsO5d6CIORt4Kenfijkc09xXnA6bzcn8aLI/EcVT6Zb13xw1b/V1DfVz9zu9TU07oJmFkrTYtKcx1nCx1YdD1Y54dRa1wTK7QVTTe3hWAkcjYo7N72nrN6wRGjtHadizwdScc2n/AHTzJJU0FpIXN2oYN9j3Lf6Mo7ZufFfW36ij0fKa8E9iPMrOw3a7q29WTNM6JMBymZmw/opKaZ0MrcL2mxvrJKMQ8rmGfsD9UTrvqdIQ1jcTjwCFsdSf8IWitGiwY15HcqcdVtIx45PWhqm/legYSOcRsVoeds7ND6RNE+oZgfFUjvvkVIdAChn9ZSyXb4L5IFp8MinRzNew2e03BHApk0pkAtj3jkUHccPJ3JGmrWCTquYc1+y66CqHqycDx3KA503YG/4rzngn19UzK+eTf1TKSn67sIAu9zka0nyeEmHg/wB7w7lHQsOORgl9xgufmpHe2VPM5rcLjjNmi3aXk5dG612nPO/Qc82aLr3/AJBZZDCgOkEE6kcQRjhd24zxTdL00cWPFNh+qzn2/wC7d3oSsfBV9tnMZp0bcYzYsNIWczfVmjT1reTsihFpGoaPfXnnN5heW/RKvoT7GKyqT9Hw+F58q0a6/jHyUGmNH/trR7bH/qYR7P3vSeeb4r64/pZavJNF4z25Nd9VlxRvkqbSTfrDOtweN6diOxmY4d+Snv1nRtH8ypaQ4n+eePkjzRR1XT55RGwXcVBo2Avf2rdZ6tijjNmqapJ62Scd5RVxmqgNwukL492eawvI4cFwK66x+IToD1hjZzUekqW0LvOtzZz8PBeU6Mex/bZvCJidnvZn8EZajCM7myjpI8ZHW/5koNJVL36RfeCA5wNPUB++eJ7uCqXMLqVvk1Lwmn6jP8I4qK5w1JqZL9oNsPmnsHUDQ73yLlPc/G97nP5k9AuzfkOSAFgLBAcNQtrCHRxymhe6wm9W73X8CtrsNJAWfL1Jh/eDeo5cdNLmJG9T+idS1L4ncNWawyA96vpKQ81as+C87Xx/FNo/pNLC/wBXKSwhP+j/ANJp6V+cOMtcOYQ0fXnZ+olGOI93o/Ot8V9ck6W5eV10cPAm5QY0MG5o1XOq2q+rJW1hFE6/JqbbSdt/4Bb4YzkE6eQknj0MlgPMcQiyIVEXWhOX8q+aJGaGPCUSeze/A8VURxOrtGk+a60kXtM/qEH1zpcOAyjrjhi5qzHDusEHz4lOXijoSWndtBv+CdTbOipYRNNELNZvjjPh7T+9Pk+saWrHySH7O9z/ALKSTFJT0bmRcC7IfMqRvbkaP5c0Be5JRRebAXKDMzmfwV9QGuy5IlEp7xcNNuaYwdaZn5qMbn3+CbzWCZkgObTdM/Z9Zye+OdviRmi1rHDIgrb0UNYB3O13kb4razyOHvWWGpzTNvORxYtnp7aD+IsGmoaofaxNd8RkqSv+jeKtgFQKX5hpULIPL9Hybejcc+bPHWbbun55nivrknSNgsEUlU7wCuTrsuJV1w1ZLJZJ8rXFo7O9YMjqOryquY07gbleR0JY3tORklLbrNVWj3MbVRGMyND28bg9/QujSyG4xRPyc1eTWmh60D+yeSzsVtIjJHvG8KPEKar7PByNJspY5sEo9TONzxyKiqS+rpoxE8Zywj2e8dyxM+KMNOcPaOSkL8MBwyOHWk9xvE+K0XoKiDXM2r+EYNie95RrGeVVzI4ordSMC3/kqHGYIHY2A7+ac43thHfkrnI4v5RdOJsW4e8pge0bo79dybUva2GPDDGC1gO8qwz6AYi92S4Drle1O8Rjl/soY/Us+Ls0+Q9Z2uy61lbRlIzi9rb/AAH+6tER3rbfRmoH8M314M11Ld6s4nuWxqn3/hu/JEz4ljoNGzfcI/I/qmP+rSdiZpicnaF01Po+r61M52ykaeXNO0bpCSDezex3vN4KxuoHUVsHnOfT88zxX1yTo2RNgN53LyTR0UPct/Qv0MllqcwHC610XFE685pPAK8z8925Gdxe6RjMR9s70+IYnDq8xmF+1/oSy2c+jnn/ACFC+ausKuhKwgrFoqeke274jjb4cR/zmvJZQ5vq35j+iEr2XNhdTTwGppgMbBd4VRTQSUVQLs4td7J5pwdixZjc7mENrcZAovIaOJsEKCDZs7Xa+PM/04Ker0iHsj281+rizwqtqn7IuZcdo3uAtGQRFr6uern4RQj+ihpWkzUscZ4A52Xaw5C+9F/WfcN4DmjI7PIcAgwd66twjxVkGgqMM2s2d+ywcUZus60UajhGGnbn75T3G5N+hnq21QBuA3k8l5fXYh6tvVYO5XIHxWDQFdfuGrJXOrejC4uHukfgussX0f0f/wA9kI0tW119zgVg0rHWN7NTGCv2t9FIKr7ej6j/AOX0Pnm+K+uP6XlGkovdb1isz3Leh0+qstVyiu/Xg0VVP5FbWZwcer7XgjUTOfuvw5J9Nk03HEHcUQ/bULtlPbrRHc8IOlc9jMA4t90r2Sg+kDGxtuDcu4lWKzRhLagbj1Hpsui6SEWHXeHeOVvwU1BOWStLSosy83vw97L/AGVNU1LmZNvnTy//AOb/AOqIcY35Ec+CK2cmLeRuUtVMQzM73O/VQaKpzjfs5LWyP5qWvazE7ySgHEZF3goKOLyfRlKyMe/xPxVXpGbrl8j3HJg3lOieBK3HUcIW+z4o7TDiD3e0RuasDVvG5B0QY1vZ3FYRa63hGV2SZT9efrScGJ85u45cBwRVly6D6iTAxNhiNNAb++/3lldXu47gtlo5sHtSu2jv01ZdDJddf2BQDv8A0CwuvfghV/RDR8/Fpw/gmv8AK6WT1cjR/RS6N64dtqcnCHj8j39C/Q863xX1x/SwU8tQeOQW/wBD5vVlrvqKvo2uh+7iWCnqX92EfErC1XJTmnE02IXlu7KoH+tbM5fEcl7J+CxkgDPgushVUUrOBaiyRsT8nDf4jJftKgOON0rGDJ7e01VlCccJ2zBxZvHwQmhMUgWPebkbnc1wW9bAWZwz+PNYnY5HYvHip6yS3WeeA3qaV95zs/u73/JNoIntbajae3xl+fBUUYfR6IYDf1tT/QoMbiKwtN1tXEDs/mmRjCw+JWIkgp878LRkmUrcMXWk97knPNyiNWWStvVlfJOllwtzTYIzBAcz23/oiSjI+wTImXPq2/6yjNIXu3no5LMBXKvQ0kfIE/8APkrOW0+gTPuTNWCrk/kK/ZmmDHONro7SDWyFnK+/8V+zakYHY4JRjifzHT863xX1x/RzsF5LoiNnEjNZEok9MLqALZWzBuMra46iRzZJhEAL3KaxxAN+9BBCmrxtPVyjA/4p1M+amPvrqLNYW24otIINiF5bEbm04/1oteWnIrBPZ2TwMk11XFye4X+aaZpI/JvMg4Q6Lf4qF7mVdIQbdu352T9FT4gMcZ3tWg9JQmaJrWzHeNxTJZnDYZ37Q3o078OO6zTaqrtJlEwY3n7oUUkzn0rwGk5R8k2JmN8eLO3NRRMGYYOTclHACIIyXHl/VVWkPXOwx/w27lic2MMuTkAm0ILJm9feE17sANm8SmQxYIt53lEnNPqAXnzcLd7ihh2UDbR/i7xT37wrb0ArKxz3FWdYq7VvPILyeGze24Z9yui7IfEpjGYRmPzTpDn0c7Ky3lZDvWNzG+622q30JeP/ANQ39Vs6r4WXlf0Moav26d5jX7c+islC/OopvOQ/Dh0/Os8V9cf0dvXRR/ezQaA3kFwVsujbUV5sLPWUeJ1lP0no5s1vrUA633289WKUBYpSro8PmvKM90w/1Kz7dmVu5YnZm1xl3OTZdECnxYHmcSY/hZNmmc6IdXcDzTLl7RgceQ/RSMJ61/wU2Hjbx3pgo5a6pPdGwb3Hn4BC62OjZyN8jgz9V5TAyEvw2BcMuP6psTS3aNkdGcFgMnBRzRYHwMPeg1/m48vBFrMb2BjLi54lQUnWhaIbHEOLk+qe4l5se9DMNTpHZG5TYohUVfZPYYN71WaVixMhwRs7LGjqtWwF5BmgwZDVv1dUHmsTQfgV1VZOldzQiy/BYsnHAzkAqWAZUDJTzldf8FUdmDR1J/hp7qsmHn/o9BMz/wDbkLQ9XcPgfo+XlfJVNJFtoSKmD3mcFZcdXBdfuCxOJWSw/RiBnvTud+C2c4cvKPoBXs/hyYv1XkmkWu4Bwv4cV5DpqphHYxYm+B6XnW+K+uP6OPSBf7gV3uW/VvV+jsw04mm44Hcup0TrhM88rwC9g6qooXOgfSuL2e3bcqGqkdNSjZE72+z/ALLz/gCrv1tkG7NSOg8oYMxxUkUnWGCRpRicRfIrBv7KZWxMaXxnALC+ShtmomMIAb3IFrmg2XnCvqbm8n3Vm2Kt4KnfBtZKmJn3d5UNJDaJ4c49ykLbB/gnO6zjdFwsCpqp4axpN1DSPDGNbPNx91qfWTCprbuJ4KOngDGNsLINnct63rfq+rDxXUKyWJ1tyJ8zTN/qUQ7CLOf3cFK8Y8PU95MiFmxgu5lVkfYkLPDJaVgzZVuVS8YNI00FZH98Zqjr/OaDqTTVH/tZTv8AAqKvc8xw+S17O3FuD/BGMljgWuGRB3jVYXXVPfr+p0kXJpf8z/sussf0Y0tH9wO/Ar67h95pCj0zBRyCYMq5YRgvudzCfBK6KVhY9hsQej51vivrj/h0CVgoZpuazOq2q19Y1+bHQHQm0fPtYfiDxVDpnzzJPJau3Yf2X/FPgnLXdV4V5zfiwrra9yaY7EXUFbRY6UCOVino5nRTRljweIRbvKw8Qssyg/e+ybchpusTnHuXaGosTm7ipH7/AMUed06Q5ZprGiWqfsmd+8+ATp/MUrNnF+LvEpkNnvF3INCBbfuW0e4q11vW/VakZ943RLSO9dYp0hwt+JTGRlkZs073cX+CBdh2O1d/Cbu/xFH7eeBhH2bM7KIbnud+CMh83Df4XU2d47fBHknwvxMcWuGdwmadYykrnCOtb6mo59zk6rL2vZgroe0PfRxYSLEb1wVzrxzZbmgNGr+ydKD+4WCvjP3rJzfozQVUZ68EzgmaY0FTaYi9azzU/wCh6PnB4r64/o7DQTObtVlddbUZZAxu8laL0Nok1dY1060bpGUtpmPpZODHm4cuGrzQ6c1bOIYGYnFUGjYNtpGcm3Bq0BXUz4qLzU/s7XIOUkL3RyMIw+y7e3wX1xnebIxzOad4NtYCzC+rqCtgtKy5HFbCYsabcu9SQus4Eaiha6OEgbyrbwuIRRtknPPErCLzOEY7yoaf/wBPHid77v6KSqlxSOJPMpkasskPJr9yFjdb1v1XdZWc2P3QvNOk9wYv0H4lEusEGs2bN3HvUkg2ryGM5uNk+SLZxNnkbyjZhajTW21KWX9991HG04Wwhw4EL2TuQ61nOF+/JYnE6tnICv25ou//AF9I3J38RiFS11TC20g7behZZ6sGidI/9myw1TDycCsf0LnHuTD9QhM+q0ZL6uqZh+PBOikfG/JzSQeh5weK+uP6F3W5my2dDEz7uu2swRSSN3gZKoqtDGheVgma8cChO5x3q682LoDUdXLVBoL6O+WzeuqOyOJWmtOYpBTyvj+6OqqqG/lFI8DmM0djs5PrNON3vM/5yTWVjMD8TC4WKZFpHbQm8NS3bM+PD568gsDxmscDV1CE2RpujFkQHt5FQO5tUQ3OJWI5KUbmO+SducFyVirewFMRkQzwCc83cbnWNfmcKFlvXaRebDMlNZIbm+HejPMTz/BCGjZFuc/ru/Qfr8VlZMylqphBHwuLk+AWj6b/ANJQOqJP4kxWlqv1bY42rSUz9tWmV7f7orBDs4KDAecmZKmYMT6QW3+rVI9vqDEeLmG4+S2WVw9l8jZb+HjmqqmAiOCSJv2cjA5q0XVVMeAfsyrG62cbv6J2iNKtnDfMVHWy3IRu28Q82/8ADpYNB1h97C38V50eK/8AtGt/nb+aNLpaOUezmhDp6Yt7EtpR8eh1wvrj9ZW0rIm/eVsu7Xy1ZoFpCdBMbDq8EURcorzQ6XAKJ+jaWSti2kkUfVjO4fBEEtrq97PcpabL5lMe47GGYd5kJ/RG/XuDzIzXncsr7iF+0qA0jvWgl8Hj7TPjvCLHWORCsQuRXegW2uhbegbp8ryGi5WF5CJRCpYXWqoMTeYfb9Cqd7/q/kzGD3i5x/8AiEJMy7F4NsFfcOhbWNVhvQtvQLu1ZY6Vx7lsNwvK7d90c0MOxZn7xTIac1c7bjcxh9t39BxRllc9xxOJuTzUssloY8TvyVP2qyrseOHP81oCm/iTfzPChYNjS0MTQTv4qrkL7U5ds+3h3N+KET+tG05cDxUk/tEDuK33shmLZLLNXRBy3oac0XJoauN8Q80/3XJw29BUZPYcKMUro3bwbKwV9eDRQZ/Eff5f+V50eKt9EqrvlH5rDVtW1odGVPvQYPl0OuPFfW3ajWVscDd73WzWhqMbDyFlTg+0fJbEsek4+5edKJK3hW18EHtsRdR3yCDW2GrzQQ6PlldtXjzUOfxTKClfKfBv3ipqqqfIWF73HM71Wu3Rv+dlpa2dE+Vv+ZOt5ykmpXd7DZb3crYrfg4JulGh4wtrP9M//wD13IsNjvCwq3FFEqAML5nZcgsQMcDRGzu4/FOa/rb0acQSDsyM3jmN6bN1i5rbb78VoOWib5ZTwteOy9hsfitEYcVNOGfAEqnY60TAe93WRed5PcnR+t6nGx3oDcVwWaLHWO/Vh4rCurvV+KxcV1bXP6Im9jm7e5YIBWVpMNP7PvSeATqh24MaBhawewOS7rqumaGXEDOAOX4KeKLaTS4G83C35qibljndzzAWi54uvNNCfe3qKCLY09VI9l7uxusPkm8EUXcegMWF2QT6KujlGRBQh09FVs+2ZiKtLHO3dIM+jdrW8GhXnHeVb6Kv+/UL6w0pumvoxFFtMEkLjgd/VSU8z4ZW4XsNiNfWC+su1OpahkrN7TdNL3ODi3Eb2GavpP4I7d3isln0wBq8wOlFoXQUYkB2sgxlo3o1lUTNXMiaBZsUPWw/FUuKwkmcOappb22uXNW9RNLH8VpaIHY10vgTcH4JmO2kaFr/AO/hGzco24pKOYSx+1G4WPyVJWZVLnRScH7/AMeKqom44h5RH70Wac3I5Fd65KWXtdRv3skyL1YxP94qpmppasMc6KIgPfwBKdX6IfT9V+zdjbc9Zh3HLkcvArG/CzEXcgFpOdmKzWN4bQ2T6Rp2+kYMfuR5/iqYZz1g/wAGf4qjp48FEw34vO/5ovu8/MnoMybK3E3xzHxTXi9NO159x2TlPT+sjczxCKPNd6LjYZlVs4xOZsIvflOELR+jT5oftCoHE5RtU1XPjmk2sm4Hg3wCmqz2OrzuB+adfHtI4vvNaZD89y0dS3jp6uaomPHHZo+So3+eqX7Z53uetGQdWBl/5GBrUZuGFo4DVyV+ltaVrjwyX1TRp/ulttAMdxjPR3q9XH/MFb6P0bPfkL159qd/9L12DtMbiCZpbREOmIfWN81OP119f4rz5WaF8tX15/8AKvOuPernWehhGvzA6Plda1l8LB13u91o3qXSNU+KBxZTN3fe14CqX7UH5qjrIA6lqxtrer/3QqvXRASYbOP694WTthT7KZh9h2R/opKZ+GpaY+8tTqd2OmndE7nG/wD4VpD7Z8NSP76Jp/NF561HSf4WgKZ18Ijj/lCdI7NxceN1Z2WaMFNLQVPqO2+K3rQbZ/CyhNU8w3h6xwEKqBxA37wbKqcC3aFoO/G+6fhuBLJ3hmFvzcnkEtaX29zMDxduTi8tjZtn92Y+akv5zNx4AJkYJfl4ZpnsMsOZR7kW5Jt7PJjPhcKshFo5cbO43/BH7ajgf3ujsqQ79Hxf5yP1VKzsaOh/xEn9VPH6hkNP/wBuMBTVT/OyPlPeVOe1G9rf5SpR6mlkldwJatJwjG+lz4B7h/VV9eMNdW4IRujb/RUdC/FCHSu+8bKaqvjf/gvkt+ac7wR1HU1WWI2VjZfVbd661HF7kIWPQE/d0MtVpS73RdWdS038GIX+K86FfQGkf+078kySrqNHTeqqQWWTqWrlgf2o3Fp1ecC8+eh5+X+VecPiua3gdC6t0J66MMhbiKfTymOQWcN6GvyH6LVkzcn1DhAPDis1Y565eDPxVZbE2LIccSrZqVloDcfaB+9aRLh5mQuA8VXzRbKajMjeRbdGS5FG9ngCntlDS2TP2RkT4KmwdVlTF/3ZWj9FDGSOtMeF8gql8Ucso2cMnY4A/BUUGiXaSmpmPftMERPyH4qfQdRgFsEpMsJYfVH2m+Ca+HD2X3GSjc68gxDktCsp+to6O495l1QxXMFBG3/AFNpA7WpcTA37JnVYPEo+opoxg4MaFVPvance4DIePM/gquX1hsBuDjuQb7WI8wrKplbiZE63Pcp6cXkjIH4J018O9YcjI5vwRqT5qaFx8LJ3tzMb3FAv6j2ueOGbh+SEA+sQ7L/tuuPxCpY73dhHDO/4ABaNZ7hd955F1RMgwsiheeWEAN/NRSiwijbzyt+SaNyub9ADXjb4K4txXXXXW1nhhA3nNCfS81uyzqj4L+wqrw/XVkr68b2M99wH6ryrSk8nDFkvOL+wNJf9t35I0ulWTD2X3QGlxUt7NTGH6uuF553Q89L/ACqziifRT6Phxwvwu5p9TMZJDdx3nof/AGrSj+/ddSVU2zj378+ATbOOLuBVt6vuTmp/8Rw8DZR364v4lUr2WLELXgl2d/iqqEl0dRFjtbEGk5KV5Mkte9x8U6V20lkaxn3zcqll0pscTnxRdeZxy+CbU0EEsIH1c7m8Ao6bR2haQHqmtxyHhvyCmrPpDUY3+bgfga381DUWxsDgeO5SR5wVLsJ4EXVXM/CdIuh8IQqWlbtp66WV3N6o8XYL7e8n0jrwwQtvk3qnJPeTY2Vr3OalqpcMYxOVHR4euJJd5da48B/VPLQ1jLPFrvJuUDdk1utvvmFHi2lHZr/cJyPgU54LZYWvAOYe3cqU+w6I/ccoTZja9+G/YkbiCqrnZVET2e4x+BVsQ7MzP8OJSnIzM+Vkf5vByd3/ADRcroq6AROrmi1t+C6ywPXXV3ryWCatfuib1fFF7ySc3FbPQE/eQ3o3cvJaSeo4xswt/mOSzXXVvo1pH/tuVqkHvXlP0X0bU+0zzZ/58NXXXnT0PPS/yrzjvR/VwOjtfoq4cYp7/MLYfR6aX26mXZX+6BcrEb/IJvHru/BFuYyTX78iiwotTm8U1m8SyH8FVVQwQw4G8XPKp6XrzybeX8ApJb4OoO5eS6OjjH/Udd9uXAIgSU7zeNyd+x4mZB9JKfHNCV5cc9obm+eadA+7HHCfj+Cq7ZTRlvItKrybCr2Xg3/ynzQufLVSyFw6z371TUcpbG4OtyTcgOLt/ci65OQ4BOqJcDRmfwUVPD5PBn7x98/0RxXOZTn5ohOhyPZTZmgjJ4Ha5+KubXse9Fu/JOHtKoi7Ejm/FTSDrnH4hYuH4I6nO7lHHxxFE8LBEFX1dZXiLV11eJp42V3rFLZCmo4KBn88i3uWz0LEz334ujmXHcFsqWGm4+sf4nVmVsvonWu/uwF11t/oLMP4Tr/jqzXnD0PrL/Bedd4+j8wOiJoamkP2zMvELafRx9/sJXfkFhy7kGxOkPgETdWRaO5RyfdKcMxmO5PZuJCmI7RXFxsm4cLdy8poG27cOR7wix2IcPxQk61+q8YHJ9LM6N4tbeEIwMfWbfeoScbLnn/4TWdkqZ7MG2dh5XyXMrLPtJwtfNztyLbtZ2ndoq3m4/iVmnA2unXtiR4lGPMFB/3SpIhzb8wo3b2WPcojuk/BC/rGofxWqJvt38E1vYZ805289K5VmvJ7l5wLczkFhjPfkmse6ol9XFme8p9ZVvlfvcVikEbVaZkA3RNt0CXJrH2d2WZuTp5XPdvOrqErYfQubm8tas0an6NVcHvNyUlNM+GZhZI05grrLr9C1d8FaZ/j6PzA6JgmbIN7TdN8l0k1vYf51n/Pgryu8V9VHj/VZLNdXU5m4p/cU88bJzs78V1s0+mkxsNimSP3YOaNO8jtMKZL2vg5OBwjMJ4vhJ71IOKeeJWDf2vyRD8RF+QT5Dd1gefAINBZH81s4u9ywsxO4C62MTpHb9w8VtsTuAFl1XO+SEdmBCShY8b8F1TPqWxVUmyh9p2G6iFdKyF+0iDrNfa1wtk5oHLoZdDu1Wy5rjyWEYPmrdcrE+6Mz+QG88gg8CGL1TP9XerDLegJto7sxjEUZ6l8h9o36GzYXceCIZh579fYatjoKlpveJcfyWaP7Kqv5P0Qr9EUWlR2yNnIusut0MOkWrz7lv6FggHXOajkfeNmHnqOrzI6W02lO/syMNkWyZ71jgw8V1fhqtkuXQ+ph33kGxY/f/8AK8ojbfii6SYD2X2RAfzai6XADvCmpmYinPNwLlAHCY81LE0SYMI4FOlfZgu8qeBmPhxT5nYb3TsDpXjduW10myAcMyhHHu4q0whHsDPxV6CZ3Jytgb8Vjq38hkgdFYj7LS1eccRzX1oA8clirHfd6usobh0PgrDl4q/cOaA3BZ3ci7uCdNn2Wje4oYNjD2OJ97VcryOiFOPWSZv8Oh7TtwWI315oy1rWjnZY64xg9WIBg/VZr+yq0/cP5KGt+ik0E5sxvtcs96koKt0MmZGYPMc11uhhr4/Fedv3Leueq2ojeLdHzY6Rjla8b2m6886WPsOG0CwG6D24m5c1hNt2sFEavq7mLa6IPNix4WHe3JbTSdVEfaJ/NN8tnZ9xhTKf6SQw8HsH4pj9B1L7ZxxhyjnobuF7uKEP0lgiHYmLXBQnQdQzB1mR4h8E2WCoqnjjgaom0ErrZCFeUunkPsNyTaantutmV5TpKec/D/nwQxNLsmsbjd/z4Iz1D5D7RusVLVs5WcvJ2ved7W2HirlNh0VUxHtutg/58EXusE6KUOG9pui8kneiVhzcbLg0ZInU0cQmBD3U7gwJ7hnuRCc85BRRZyOv3BOlbgaMEfLWyjgNVOM/Yb3p08zpHm5dqsr5ncr5Dd0LZoRbSqfuibiRfIXHecys1s/o9XO+4fyW20ZVwn2rj8F+2dAmM/8AqqLs97eWugppX+W023aRkoX1L3QjAwnIINqGHvCFmO5hDM2Q5Ie6m8k33U07wo+Sj5FRKIcFog6Gi8njd5V7ZUSjTExM5pqDm7N3Pqk/kjA8+7wRYbcE2Qb0WnXfXs4pWHc8LyWuje7sX6y8n+kJPDakfir/AEhmjvk6K3ysVsvpFFIPswxNfoCrPsmA5oOopY/ckB+aZBpfQ0zvesfmgaCe/wDDd+RTf/p0/wA7k2m0JLzl821A6Knf/eBBj307D1txTWV0tM77ZuXiP9rptNRvjb6yXqfDihPS1T+MbQW/NbPSmyO6VuBeUTFjOwNbWMdftbgi4qwu82CaOwE5285pre9E7gn1LrXtldCSVzHm1gSO8jgmyN6gtnxKb5TJTOaHYwSL8wmbUmPqt4BNDXNOYKaZLP3IQSYWkkb+g1rTNMbNanVUvJg3DXfeuA3dHEbLyfRzKb2pOs7wWa84FsPohUu97L8V6z+YLyP6ROZ7Dnlh+a8mrJYuTsvDoZgraaOhf3Let/Ttq6nQGu4IO5YWiGoGNnB/FW60ZD2otKJFjqKJ3ph3hYh1TdW1FpBTv2nFNK6+YBP4LFpaY94H4LH9CZYyfPC0HwX7N0iC/wBVIMD/AA5qzaDPg4g/JNn+hslbf7Ag/wA25M8iqaZ5tgdtPgv2rXea9RDkz+qjo9CaQll+wIen1lS+Z/acbqWmMVQ3qm92O8FNXTbSZ1ysGiy/+K4owVBwm1jcFX1XTpO4c1HTizOs7mnzP5lNiG/NPl7I6oTWjFN8k+omsLNHDkFHT45twDcDLceZVqjak+1dWbhCMcuMHrInVd4WN5XVWJRwM2kxtyHNOqD7rBuaNfE9MOkxu7DOsUZ5HyHju8NXXWw+i8MP8XP/AJ812/ELBpqVw/iu/NY3wT/xGZ9HbaC/kK39DfrsNfV9A2tpp6f7S2OPxG8fJOjdlki7fmmGDympJEfsMHaf/smz1zY9zSVGNISNi7DDb5LZzYBnuWzDDwd3KxGE5oTN5ORYVmi0jv3J/lL9r2+KdSsgd7MzA9eTU1DMwduIB3ip6iCGKWQuZAMLL8AqiLRstEH+YlcHlqezFhcRiFjY8EK/SZjd2WxuP6D81JCJYMRDXEY287LNYfo3QniwYvg5XK8nooo/caF1wfEIF+e5YnE8AmBu0kNh+aLhybwCdIbu+A5rBeOFuJ3Epnal65PDcmNzcQLbgNwUZ6uKzRyR9gWT5N7lbNHVZSSHJq2HVv10Sto7LsjioaYdTzj/AME+V2J5udYHeiekXFbCEU7T1jm9Z+Gq+XMrrsgG6JoasML3fFY6wv5vv+K2mhoHe6bdHaUdRD3KxcOlbodX0DopGyMNntNwUyaIV9O3zb+2z3HLC5Yvo5Syxjcwxu+BKNPWCZoGRxAJ0lVt3i5JuUJHsqY+w9ufcUyaARvycMu4rtDksPXHxW2j70RkVip2v4xuHyXXgqBukbY+IV9BUzwPVMYfhbNftH6K5DzjI2ub8tbtmZLHADYlYfKJ+bgz9VsdLzW7LziCMkzWN3uNgmM0XsGeyAz9P0WPSEbD7yGyktwWNo8SV5nLedyDG57h+KxtdLL2RkBzKuccgz4DkF1bXsDvPNRQtyy/VOPZTpN7r+Cwjrmy5LOyfKbMbdO9vJUrO3K3/MqGLjjP3QnObghbsx+Kc7+qYzJOf3NQ9BlnrETNs7f7IRJJJu46s0PK24uxGMRRnqMR3uzPxXkX0ZqJ+OGw/JedCxaFI5OHRw12D3xZYKl47+jbo9X0LoCbdZjsnsO5wTW+cj60J7s2lN85QSnzc3YPJ3+6dFNsXbwclij6osOI5FWDonZsdwPNYJSBwRY/F80HZ8CthKC7Nm4rYyX9l3FeaezmF5TobCe1E8P/AEKZW6AijP8ADwfoj5N5M+92DAfghSaWnawdTFcamwfRCAe0+TaO/FbDRkI9/E8/l+ixGOT4LHpWG+5rsR+CDi5oPtN/NbDScjtyxuLRxWyOBxFxvtwVm2RMDJHm2LNrO7mhfG/K3ZHJBo/JSTG4yHNXF94947k0HLrHvTjvNhq/AXUTetJn3BSYcMXmm/dT39pzinHmpX+zYd6hh3nG4ck8gtGQ9FhF3K51XzPZCLzfhwGvMu5LZaPf705t8OK2lT8V5LoKmpPafmV11/ZT/EdHY1LH8ivPbTg4ayg3o5rL0RB/NNf1o8vuptaBDVdWcZY/e/3UkMgZKLOO48Hd6z71fPig8FrsjwKfA7MeKFRAbbyF5VoS/twHPwQjmB9kgFYXSD2Xg2X1BjeRsvJtNzs9mbrBY5cfNoeP1WJwQ/Y2zHs4QEIGU8HtMjCEkIvwddeS17w7lkjguN9wU+TrgXztfvTYIG3c4VRz35MTATPINoBuvucrvy38UQxuM3NuKGdtwyWMbSQ9TgPeTYxebf7MXBviny5u3LkFfvUj+uRZoQYwMHbOZQG8qD2pPkFRx/ZF6a0ebha1Szdt2XL0PPJYjYIRDm5XKsr79yxdzR0C97Ywh1gOywYG/qjPWsHBeU6TcG9mIYAuuF/ZrvEa78dVl5VoWOQb25LNMEo2nZvmoTMdg2zPRW9CyQdcA/mnsgwnz8fGN35g8CqadobUnL+MB1m/zBVMMG3jw1EB3SR5hWNisg1+Y/JbKrDCbtfkmw181M/sSiydHpDYHcHZ+CxbE7gch80+jDor33Ouj5RDKcrZLyqwLtzHfkSomfRukqh63buB8P8AgWOnwn3gntqXyP4DknVVe2Gxw47ErzwmjBAYLE8uSbJHbjyUbXtkdcxRjqhPqJSeMhXkgbHfsNFxyKc54seud4KxNFjnbNY3Wtktj1t7/Z+6uLus48E+13dUcyoGby6Q92SYzsRtZ37yn8MzzKLySTv9KAnSOTYW2G9OraV0u1DTyWzcW7yMlzWLuC5dDYwOm9o5NV3BnJCh0ZUVjuWFnii9xcd53rrjxWGgaOZW/oFbWlmpzxGSc17hyKPSCGG989RbGH8PSivpHT0lm1EXbj94cwq3RM7vJ5HQn24zuPwWjNJD6zCaKf8AiRC7T8E5tPt4HtnYN5YbotsUcUVS3eQHoz3rLdR52bO+29On0RI4D1L8ljpYZLexgJT9hFPiJLLZ2VmLyn6JyQjtRHaNR2dyMkW0wkxWElslJgmqDnY/juH5q1HK2Qhu0/CyDqoB3Zuto2OkgaGPk83b8yo4qh1W9u0ZD6tvvHhdY3ue7rEm58UYYjI7tO7K3hEXdzRaMbzZBmUTc/eKe83c6/7jfN2QQb1Y/mue9SNiwsNjxXE7ysXguA6G1kA4cUHus3sMyajLMAM7myEEUFAzdGLv8dV5QFYRs+7n0CitlVtN8jvWzqS4bn56t/oC4WJy15eidSTiVmfAg7nDkmzxeVwAywf64u4qwuCHN5qWlkxxPLCmTgvADXntN/ULHoqI8W4mfr+qGjtCaIpfbbG57/5jYp/kz4sVmuZcrbaPka2+MC4HghM0Qu7MrMKdTVL4XC1ivqhuL52KMUrmfeKA0PEBkcTLD/Bmv7NsfafcrzVhuTZKouk9XGMbv0HzRZKxzMn9YXHei2nEDMmC1+8objvRlk7kDE55NhbJRxda2M8BwT5XXd+4l6ZDvzcnSeHJYfHXxK4Dd0LrZRYG7zv1NgbJWS9iEX+KdUVD5XnrON9W0qAsdQ89/SsUKvRTZvaaM/Sn0b6aTEzjkR7yNERNIzYY9zwOp4EeyfwTonWcPAjMHV5U4U/98w/DijPFSze/tf8A5ryekuN74sI+a7N1sq0x37ElvxVpdv8AAoPo3DvRkrsDfeUMr5YJ8WCBmJludk5lLE32WNufFbR+/JfV3kcXIy1RPBiwdY7+SxnL4rGHPOTG70Xmw7I9GV3oDd0Q3ejazcl7xXy1F25Bna3rF4dHB46nTyho3k2CEETKGM5MzeeZ1ZryWmdUHwC+sOtuOfTxskp3e0MkYpnMPA29IPRip01SRncZAhQiEPjEsUxLXsPFqFKzyqiO1oJd33NVtPxt95p/JA09DHxax9/86xMhZyjQxN/NYNLT4d20uEJNHHdewKLady2mm2jg0XK2k7z72S2FCOZCOa2YwWyJWxDo3WB8d6xuNvBbd9tzBmSmuGxhyib+PoigO/0HPIIN7I+JXNckTm7IINFmq+/p8E3R9E6sfm89WMfqi9xc7MnfqMkgAWx2NI32es7xV9mfuDoTvpnVAZ5pvHXspg8cEHBtQzc4egy9Pb6Q0X/cV2U8vAXTqCV0Mgx07+0xMweVUJxwHO3urybTFNLykH9FMy87x1HyyMB8Ex9NG0RYZG9p9+0FZlyv7SmI3XBV9H4eLrBbKDM2QYZqg73dVi2s13Hqt4oTSZdlu5Auz+A/qvMiw6wO9NxjB7ufinTSBjcySmxM8lhOQ7bh7XROrvQTeXoC7cmjtH5L3W/NHe4oDcnP35BMj7yi70IleXydWJmbijWS3blG3JjdVyhE108nZjF06rrjI7e5y6zRyaOhIIjGHuwHe2/QbV6PfAe03ciyRwO8dFrndQYRbVl6fBpekdylb+a8uoK+my2tO/aM8CtjOeXBTUjuo7qneFFKdtF5t28sTZNCaJmHZmlc93iQEYrAgg2vnknMgOyG7NwPjwRdUF+A3NsgjKGgggA8U+rGyp252zLjYNCY/wBXI7xcbfgpo4S9paWtyIx7/AI4Osf90W+K8yB3onNClh2cfrXDM+70Cj6IlcymjcLpzkG7yrZAJ71HFvzKLt2S5+hdUy2G7im7MU1P6pu8+8rajJJZCGBtHH4vV57rzx+WodLYzhw+KDZdq3su1Zasv3Lb6RkmduhZ+JyR0XpumrPsaiLBImD6xD14Jetcfmi056vKfoBC7jS1Nvh/wryyNkj2jatJa5wFrjhknRNdnk7qlDEHE/LenTxv2j8bnHqG+TeZXV2cXVi/+XiguuL7rraTZbgrsfJ8ArtDnnCy17prGsjj3gZnv/cDqARO5OPayUUX3inO3ZLmbK27UUNVteSfUyWb8SmRxeTU+723+9rxOsm08Lqh25nZ+85Omlc9xuXG69pXcT0HMdZzS3xHRFXQuiPabuWFxB4dA9Aooo+iEOg55vfJ/BftX6GtezOWgkLXLZMdSVGcDv8AStg/fjjPZOrynQulqDnHtm+IQLXNPtD5FMwyRPGT2ZHk7h/zvTpJQ0DEbEkeC2NAyMdqTM+GuwRe+w3lNiwRPdYNHWW3cMAwsYLNH7jyT3dyYztuugOw2ye9AcVyy1OPcExm7rFOerdoocETqATpjnk0b3FAR7Cm6sfE8Xa7ozPwDLmUJCIovVR7ld62cOt8jsLBiPcpB2nsaeRK2jcFTGyVqgqWbSidn/DKLHEOFjrMUmIIYtq3cemdXJH0eD6KsYOMRQodNS0tX/6as82/x5p+i9KvhPZ9g82rC3Znrx8kO3EcTfyXkOm4JD2HHA/wK8i0lPB/Dfl4Lq35FGN7JGGziOCxPufDWSU2kgNbOMh2G+8U6eVz3bz6dx4I8SAoxvN0B2RZOdx6BO4K3bNkxnYb80+QoNzeVbJgsi7PUArprBilOXJOkGECzBuHQxmwWzZsYTl7R56sUis0DUXvDU2lg2cO/wBp/NEvJJ1OidcFNrITI3KRoz7+gJIjGVs3kdKFodtgSgXEgWHpcOhoGc4gPwWwrnSM3OKh+lGhv2fUutXwDzLz7Slo6p8MrcEjDYhEbjZX7ivLdG0ekx2iNjL/ADBXhtqzROWoOBnnyibvRq5chhjb2RqCHND3l3hd68EUV3hfeCb7yYm+6j3BO5o9EJo4XTuGXgnv/wB0xgu8oewi4o8U1iusXHJNZ2MzzKLjcm/QxFBrSxnx79V1dy3hS4b4HW8EWk81GyLHJnyautkxvyUFS3IbORPgfhcsJCDZ3AbuGuy2jMQ3/unk2gqOcbmYL+Fs0x5L/s5cwVNRVWNjix7DcEKl+lVMGSlsGk2DI8JFLSTuhmYWPbvGrymCq0W//qG44/5wiwua7Io2Rss0aqqawIE+Sw+qi/1Hn+7uKHE3UcaJ7OSe/mVbtFMbuROoDvRcVbf0eWsuctlTukPLJMpvOvaHP71NJ7VvDJXJJKLtWaE0WfaG5WdvRnfcOb8Sqhzerhd4FTwduNw+GviP3J9dVthZx3nkEP2FLSjcBhb8E2toXUNQevH2fBEPMMmTxuPMJ0T8siFT6SgFNpYdYdipb2h4qajGMETwHdKzcn087JozZ8ZDgmGdldD6mrbj8DxHQ/Z+iHTfazdVngr/ALpzNkzvKDdwUjuyxTu3j8UPb/NRx/7ocE53HWXIDtFW3eguV1gOKwBkXLeu9XRWesjURxT27nFTMyL7jkVT1rMUQEc3IbnItcQd4VxYrCdeL0tyvIKKK48/P1j4cltKGpwdthP9V5LpHyqHJkvWHcmaSg63VnYt7JRZw4qxVRRnzT7D3eCo6q5LPJ5Pu9k/Bbejl0a8h2LzkDh73L4otcQd41GeoZGOJQkq9izsQjAP3ILkFzKA700bwmDc0ID2rJv8ye7dkid6A4rkE5ya3eVyyV/QXQb4q/nn7moslNzfirqERl82Y5IO7DMA6AWWq2ogoPAk48dWII5+kOvyzScbD2B1nfBf21EzlEVs9P19G/242zM/IplQ2al5G7E6CUtPVc070JxaTJ/Pmi3I563RkOabEZoVcXljLBxylaODufx1bLbVB+yZki57nHec/TlOXNMHFNHEJnNDgE7gpn80fbeAoW8S5Dg0KR6A7TkxnZF053oroRjvTqiawTafZ0cW/e8o2F+WokW1ZKQtvrbxzVM73mnxRZmM263yMsBdSe475IjeCPT5rqTz/wCFeS/SCiJybJ1E6g0po7Sfs32MvgU+OsLmdodZv3mpldHto+2N4Vrgo/BDXsXm4uxwwvbzC2T8jiYc2nuWHQk55kBN91MQ98I8wfin+6nDePSSv7LHO8Aqt32Lvjknj1k0Mfi9UjO3WA/yNuqBm5ssnjkv4MDW/ip5N5/RHi5Qt3uumNya1PPGyJ9HdYPFOkdZR6LoTM/tnshOnrNo85k3WXQCtktF6dZ1AKSq/wBLlPQzmKZtiNZAwncrOR5KVnZeQp2n1hTZ+rUMDx+Kaxu0idijKHoo6ebHI3EOSE1Q5zRhBOvYaDa7n1ij5PTzs9gqP6S6Ampn+uI/1J9fQGjm6tfR7vvJzZi5vVk9oe8mVGbeq/ki0kHLo9TZndwX9jyN++OiRxT/AHk/u+S+635Jn8MKP3FB7h+apvcd81Sfwnf5lRj/AKd3+dU3Civ/AIim+zRR/FT+xDFH8FWv31GHwKe7t1JKgG+S/wAVSt5H4qBvZb+C5NTynO3lW9D39GwsEXlMpYTUT5NCfWz4jk0bhyXXvqxGwGagp4sdW7M7mBQ5iOFrRrdG64KbpfRhjkzmiF2n9FhcRqKwHdcos3saR4KnqmExtDH9yLHEFW4q1439koYiB6V9fNhGTB2nckING7KO9mtsE3S2hp6Z/rYTZTaH0ibfEJuloBpnReVVH6xnNM0nGZohgqWdtnPvWeeTlfJ4v3rlmOiRA6PgTf0xTuad7xTveKceaeeBUh4I8XAKIb338FENzSfFDg0Injq5oDoEq3TLyoqWLbzmzeHen1j/AHYx2WrEbLCL6mxOxngjLI5xOo8tWaMU4QZWPw9k5jVY6yw5IPF9VigRdzrBUg9jF4lUMm9hb3grze0p342ojI7/AEBkkDQLkmwUej2Uujo/WTHr/qh5ObeCl0Vpc1LOw/J7UypG2iU+jqjaQutzbzUGlvr+jTsaoduL+iZUuIkbsZxv4Ap0ZwvCI3FX3/unciOATuaf7ydxcdTvdT0eaY3eVNpefZwDxKnFLjicx57nKSnndG5pBG8Irmggh0i87lBQMvJZ8nBgUlW/FIfAcBqxPCDItVtQY+5F06bI5Aa7OB71JWHqMLiBwCkjPWaW+IRUMnbnw/BSFmKne2cfd3p0biHtLSN4OorNcAisDr708OvdNlZtW7+OoyvwjeU+iw47G/R2teZDuiF/ivK/pzL7sMbsK2mj3u92RzT80HyO5OzRpnljuwsXWbvUkEgfG4tcFTaUH1kbKo/iDj4qal6so2kfA7wmv9WfgURv/c3dyPNc3gJnFyhH/lQt91RjcU3gCnHcLJ7uJTypqX6NnybLFJaUjlwQ0Vo00xi2hv1Ea2ulnOReboD0IYMT3YQgxuCnbh+9xRJJJuTqxFYHtYO0VZtui47mk/BEZFWKspIey9w8ChONjVtErD729NpZsURvE/slWUkD7tcQVHpWmMoFqlg/zBWJ6ZLcKdJuspqftsLeRT5e24u6OwoJ5j/yyt9Mjf22uYraR0no5+++1Z+q25dHez2G4Qeizqncgd2p8Yw3xN5FQzZxnA7kVJHlI3EEx242PeiN4/cSjzRTjzUnulEbyAm+/wDIIe44r7gHirapqN5MT7XyItcOCxk2ZGz+RtkSiemBvNkGdgfEpz83Ovru5Nijxv8AgjLOZDqJ3BVLm4sFh35KSPeqaOLb1RvyaiOrAxsbfBPc6539AtK8p0YWuObMxrMUoIKHlL8O4lHoh3GyfGL7xzCczcU4t2c3XjO8IRu6mbDmOhswyz2yYhfq8FsNDPj47MuKNDpvbt3xvDk6l0lS6XpfbTayBmkabJpyePdKxdcfFBwRbu6Dm5bx3qKThgPcpW9g4h3LPrNTTud80emSnIocU0JnIKP3VF7oUY9kJg4BNbuz8Ex0XXYGnxuVDwa35Jo3DUOaGon0PLpANxv3Izy5eAC2FNuzKKZTMxWBkKe89pO5pzm4b67osKuVYohhHMLfqs5Ne+7z8lRT/wDUuYe8KenbjZaWP3mrDv6Do8jm1DtM7JWaxwYSjw1ZpkTNtJzDG+K+tVEPKEfmVs653yQ0noKXR8jvORZxp1G+WF4vG/qvYgM25grCSQgekRuNli7bQ9QSbiWHvUw7HXHcpGZPYm8rIc9RRR5p3NHUECbXsmgmxQGonfdEbmqQ8E/mAj7yKKKPNDmm6ydwR4myaO/0AaMT935oyHu5IzzBx3KOnFg3MLaOvuRR1VNa7zTMve4Lyb1lTGDy3pod60LPVnqdB2DZYhhmiilH3mqhrx9X8xJbsE5J0EhY8WI1EKWnfa928QoZ4PK6bd7TeXR6mFFxyT2b9yjJ61/gmkXY6/cVc2WwrNFUo3CVr3p1N9LpIz2ZY8KG3xDjmn0lU2VmRCZVNFdT8e2F1bK6w5rn6At4p+51nDvCo5u3Dh72qml9XPh/mVSOxhf4FVcXahePgpGbwUeS+6m8k1BBBBBAcF3J3gjrcnngU8p5Qb2ngKFvNyaOywfFOPH0F02PfmeSLjmjPLYDJeTQd/RFVeabqwM3rA0wUnm4xlknvcbuJR5rNXb0CE5jhYoV1LtPtG7+/oYSY3dlwsQsEzh39I2wnMKzskVilB4BEabuMsDG2WM0Gk4fbYm1lC2Vu/es7hOpnnix3aCb62HNh/DXyRagfQ96e3c4qZo7axduKN/+FUMnbo2fBaKf9k9ngVo1+6eRvwVGezXW8QmHsV0RUn/uYlMPtolP/FjUv8WNP/jMX9+1RDfUKAf9QqRv211SN9q6p27gU0bmJ53ABPd7SPH0XNctRleGhNiqo4WZ2OaZs8zqc/si5VQ0XMTgEb2K2OhxEw9o5olxz9DYrKyzOvC5Y336d9XUc/hisFbTX80YX7T+ik9H9tRu2jP5UYnOhd2DuWF5tu1Oi8OSDwXx/EK2/UHIjci1D0GXTITuadzKd7xTuZTveR5oo+kMjrNFypqb1rCwnmOkZHWCZRRljM5Tx5J0tW0o4c2lF77JlIMEDRfi5SzHrPKbIHPklwtCa9uBnZCv0C7cLqpw32LrI7jqtqwlaOnbZ20jdzvdPpgHtO1iO541FxyCMrcpYwe8qamPXGXAjd0rq0Rd8gtjo3PtWxLbRU9UOHVKdo+vbN7O57eYQgqy6L1UnXYe5bRuFyLTqLDcJk33X/mnMOeoIFck9i5oHd+9G1+HSdR1TJ2dphujp0xufA2NzOXRdL3AbydyZTt2cGbuL0XPud62EBl+SO4OTmh2aJ46hsAwDcr8dTpI9o92Biga8jFqe1uRVTC8BspseCgn0Y2pMYbIfdyW/oG6fLTyQvOJllaRwRtZEbinTMdFJ1mWTNsW8EyLs36ALwE79pzs9mKPqpzqOG/Fi22h52vzDRlq2+gX7TPYvszuXWWJhv0DJk7MKx6F00KyJ/eM1Ty//h3MXwMLmuydbP0bHydYJ+PZDqsHALNAyC6w0jQFv1ZaoXQ4jcnxQa42QdOL80/Jl8lmv//EACsRAAIBAwQCAgICAgMBAAAAAAABEQIQIBIhMDEDQBNBMlEiUGFxI0JgFP/aAAgBAgEBPwHBrCryQoKatXpdCqb/APAxeqmSlQMT/wDMRivb2JRqRqRqRqRqRKJV45WL1PmpF5aX/SSjUkfKj5z5mfJUaqjc3IZDIZFR/I/kKupC8zF5hedC8qYqk+V7C9H4kfEvoWqkoqT4fr1taQ/OfMzU2bkCpIyjGCDSaDQaahOtC8rF5UKpP0pjkqp+0U1TzwRx6kuyrzI+Vs3NJBBGEEEc8Gg0MmpFPmFUnzxyIf8AGr0mhYyVeVIfmbN2JEWjGLR6roR8bXQvLVT2U+Wl8ac8/k6KevVbH5UiqtsgSI41xKZ5YH4/0Kqqjsp8yqFwRHP5Oijr1KvIkOtsi64o9x+NMTroKPIny08CK93Aubopqm9XkSKvI3ZIj2Y9KrxSKuqjsprVXpIrqVCKP36CUFVUFXmno7EhC4I9V9FPO0maGvxKfNH5HfI5+raWRA/IkJOrdi9CvypDqdTF/XuuGLq9VCqFVV4ymtVcv/IaamVR4ynfndUFflN32Jf2GmcYnsdDp3pPH5Z74tja9dKq7KVCjmdcFXknqyX9xVRPR4/K6dqhOcpjsq809G7Pjfo11wVVN3X9i5E86qZKanQ9xOcfLUU0lCgnNcNdcFT1CVo54Eo9aPVakTfjKa5WD3qKKfRqrG5Fdersa0j5kf8A0Hznzi84vOj5kKtM792JN6GU1TdU/wAhehXXBqli4FzSkVeZIfmbP5M+J/YvCfEj40fGj40fGj4jS0JtC8zXZT5U7x7MSb0Mpqm0b5tC4PJXA3qF6swVeaDVVUU+J/YqEuPSjSQmTVSLzlPlpd4xj0ok3oZS5V4I5K64KqpF6tVcFXlkponsVKXPAkOj9Efs1VUFHkT9lqRN0MTnfmqqhFdUiXpSr11QVVT0U+MpXPJN4F+h0/oo8kbO8etVTJ46tLh5LOYPJXIlmuN9GqGU7oqqgqclFIuSTVkneSJKanSJp+xXTPR4vJ9Y0rPy1i9JW0I6K6pKVJSuKbwQRkroiSXSynyz3lUpFzq1dP2jxV6uLyVwoO7Ky9FuCqu1C4oFSaSCCCM1gxKRVukprT9RXf8AxuUU1SuBuCpy/VqqgnUxlKKVwQQRnPBpNJvZER0U+UVSfpK8TsQ1weWsWMki5W4RU5KaYRBTSLgWck3gjBWliqNKZ0IdMildFFforjrqhEy7K0wU0uoXiSNKNIv1yeSooUs+hLcSFnAuWc0O7X2RJTXGz9BD4vJVIsEtTgWEcdVcFTPGoVl2LgnGSfSVqdmPcoqjbkne1Tgo3Hl0LyK1bhEy8fCvvFcPRX5f0dsSliVl2LOqfoSqvPEqTSaSCCMkJ2RUtjxV8yzqRpbZ0eSoVlfxYIpPvOTyeQpQzxd4q644wVIqCLQRmusv8Hj8m0etW4R9ixWzO7omLLDZFXlNbYqWzSyP2UKHnEop6umTwqkVHC7xkir8pGoZRXGz9Xy1CEsqXAqkakaspgrrkppdRT40rxwqzJf1ZZKmRUojgngQrI8g90fR46pXptwipyxcEECy8lZTTq3FTGMDQrK0xjGapFlNtRJNpJsiqyuisXQuhPSUVz3eefzVbCQsFmsKnCO2UqCROcaqZIERaJEow3srRZISxm2o1E5qyFdFQrMTKXKGKefydiWK4/L0UL7ESLZieMXlYrNLKR1E8SxQ8fE/QrcI7YslisfMeO6YmJ5IakXBIsZJJG+DuyzVlgimqVz+aoQhc/mWxRgnAmUvjRN/9FM/eMk5LFcCssEdFDnm8rlwIQuN1QJzetShYplIsGpFKOyIt2aSIw63FvaTUTPrLBdn2UVQ+V7I7Yhei4Vkr9i2FVdYN/RUU3gggVP7tJq5FZG1oNKNFlmr0Pbk8r/iUiusVvisPLKFZXVlUUsm3WDKehW1CbYrajsk1Wp4ET+iCMN0LyfTHTO6OsFZ3RRXB3x+dlIsVmsaqZNLVldX1wU1TZYoWGodU5IWKslIttsoGimqBpVdEcKPFV9PiR5t6oEKytMKRTWKV3xPYfkP5WV1Z2pYqhVGo1Gq6ZqNRNpwYhCxotIlhJqJvS9LKqZUqyxVkdOTvhRXvUK6s0UqHx17uBaVxIV5skzfjgQselalWgi2xpIIGh0P6PFV+yum7urIZRuuH6O6rrmRX2UzPFArpCUWT/dnxJY0r7JEJG5BsQRaRVDdmv8AsJ6kQfWVIzw9Zvoeoq6KfRrY2UqFeCCOCDpiIYip2jDrhVJJAr7itJOCF1B4irNW8dUMWegr/E8dlz1MSFnGPXQv82Vtz/ZtimumOj9G5JN0iErKmyJYptJqJJyo7Ks/q1PZRV9cHl/Ep9CpwhCFfe8EX3IsrQxJ2gg0SaUsdyUbG19Iqb9m1myboggV1wfVqez7Fus/N+JQLidSRTVqwr7iytIieBJ2QhFUlKtP6tuQQaSGhIg0mkhX3EJWknFXV1mh2XYzxvbPzfiUcddMlChYVd4Imyu6ZNMCp/ZpQ7whIYnnJJ/oQs9RqyX+SBYLgV6exniefm/EoELBcVXZGMiZJqJJHUSKqyw6vN2xEHVpwkknCclZWXHTaj8s/L+JR6DzTJJJJNRM3QmSTefWQrIZ9C4FhTZbVCcrLyfiUe1NoIwRImSTnTeD6KR4LBXRIuenq/if1lV0UZ08T5ownHok7tTf6suBLCeBcP1dbMTnH6Ke+VYvKLoXWEiZ0SKrcdUCqur09cCXBv6n2fYnDvVIlZd8dOrVm0RwoWE7jPopPI9ygeNPGkQR6lN/sZ9Cxq/L02RacYy+xlJ9laKVA8FmlJCVtJFovPpKyF2Ps+ijHydi9JqSIskJDtpNJGUkEZoZThTTaLyaiSfYXdqcfKU+m1khDRGKzVkMW1kKm83kkkkl+vT+QyjvGtSigXptXV04FudWRGSsrJb4JSIngjjWEcLKLU94/RT36rpIwkTg7sibRhA1sJXSkVMjQkTafRSwjBZMp6tT3ebvsVqfTiR0RghcU/RUroQ19+ulJ0N3XFRlWvsXr1UkXWcHQhDIEJYt8sWjBIkV44FenJ9C9l3QuCLJH3hJPLGKUmyJwS5Fkjp2Xpq7X2d3QsYIwpFu7Nk8sZInDsiCeRZ1FPoLFWq6KJdREXpvBGSKRsnji08aRK5aSnDVgtnHr19FHZ2NWpELhknOCCDYnJEEYQbIniV2IWEYV7C9avop7wQhWkn1oItvaSGJJE80lK+7Lgakp/XreVwUqRXQsFg7TzoWCRsifQ7usJyXq+Teop2fIxv04ZCRqRM4JXj0tJuu8U/Vq/IqplC6wWUjfoQzSbEonBISIV55lelWQ1J1xuRcn0VdlDlQNNPBZN4bXgggggghGxKJJyVptBNo9FW/ETn2olweJxtbdcD9dEEYQdEk3jhVlTZZz6tdUIpq/kRDlHZA7rB7+pAkRhFtRqtJ2Rx0rJXVoTNLXqeSqSnZlG6gW2PV2L0kJZOo1G91SyETyLliPSrqhH/Uqp6ZTmrVfoXoQJEWknDa25p/ZsjVy0oWSeK9NHlf0U70lKlQePrhfd4III9Lc0mlEonn7dllV43Tuiir98GkjjqTZRsr+Tdng/RGli25pJJJRsbGxsbYIggi8km/o9iGKylipwrpjcpcrJehVXCJkX8WRqR1tafcjKCOZXVlghqUU8MSaDQQ0TwSPfcRTuinY7Ijvij1YIvrFuQRxJSRgrKysrzeWJ8DV5xr6giKSIPHhHqwQQQQRkirZChlE2kknOMpJvJODpF+hWqUdCzfBV2VL+I6dpKco/oIRJOaV1g8VLF40acWJk5TjpxkXZEqCnqCnbbg0kPigggggi8cEmo1E+kkIjgdk7QQxVfvJZdlIuuTSjQaWiSUbZSSSSSSSTjOG1ptDNLIXFUKytI6yltm+WlMdLQnd0yb0uOOhbFC2Keo59KPiR8TNLRuamajUakSiUSSSSjUajUaiSSbbmlmg0o2J42TN5JJKPElu7Tn2VUwKyKlKFxePop2cC29OEzQj4UfEfEfEz42aGaGaGaGaGfEz4mfEfGLxo0o2NRq52K+xB4qd+ZYyTl4+j7kV0/akkkknlVXBUKbKkUH+EUqBvHULHSz/d2mic1ajJOONe81JRRHBNlZFJJIpZpYhDpUHTFgjyIpvWLJWWausl7NPfM7LBDGU9W//8QALhEAAgIABgEDBAIDAQEBAQAAAAECEQMQEiAhMTAEQEETIjJRQlAUYXEjUjND/9oACAEDAQE/AdmFNR7Jd8Zx9P8Ayl0eowPpdZ/PnROKii9tl7rFlZZZZZZZZZZZZeS5zvxRS+fLDrNTfhwPV/TVGPjvFZg1qMXCpWfO+9152Xne2yyyy8rLLLLLLLLL3KRZe9ZJ+b4IeZOjU3wfy8l+WyyyyzUajUWy2Wy2WzUajUajUWIvZZZZecXWdl+WHWz/ANP0fVa7IzUs1v8Anw35bLNRqFqfwLCmz6D+RYCPpwNMD7TgtFotFo+00wPpQZ9CJ9A+jJGmaLa7NZZZYi87LLyhGySryfBEWSLRJQl2jE9Kv4CtOpeH+XsbLLNRqFFyFgP5PoxQoxXRZqLL22Xuss1MUi0zRBn0UfSkjldimJ7LExClXh08bP2R2ajUajFipIX62WWR5H9pf3Fllllll5WWXtsssUZMWB+xQijUaiy91+x1FjhGQ8BrouUexTTExtVkmajUWWWXsUtlkcrL2y73R4HyfyKK2VsgyVbLOWRwm+xYcUWkai/eoTOH2PAT6NM4CmJrYtkVbJx0oW5Z1tkLeu/Heabk6QsC+xRSLL9rHslhw0WvJWSZLBjPolhzgKZqE81kmarELavBLvwLvfZexz+EQwZS7IwUS8r9vq8Nb7/ZL08ZksOWGKQmLciyyFMnXwLezt+Bd70rJw0rJyoUZ4hDCUDUWX7utq8d/DMT0qf4H3Q4YpCfiW+Ur4KrwLvfZKbfZy+jD9OlzI6L9/hJOXJjQiuvDQltXBOMcRUzE9NLD5iKfwxMXgTyw4xfZKrPqRPqxLcuhRrw/O+6I4cpv/RGChwX4L91fiRRRH01xslGnlQjG9Kp8xPuw3TExeGyxYUBQijDiqsxsPjV4fndfwjDwb/I66L/AK1ZIjjNIbvYieHHERiYUsIjKxPdZqNZreSMLE0mJjWs1ErxJOXRDDUSy/c2X7RZ876T7Mf0zj90SMskxZyML03/ANEPTo+hDckdF+CyEXJkYqKLL/pFsWS2LNGC4p8mJpb48GP6b+URP9iYs8CF8kI0SZe7Da+TErwWQhqElH2UGk+TFkn157yW9ZL2WP6e+YnK7FngR+06G/O2YcL5OvcaiypP4FgzZ/jyP8aR/jyPoSPpSRpllYvAskLwLwoxsBSVorSITMCX2olurwtmFhubtnXtbzjgzkQ9F/8ARHAgjTFH2mpGos4ODSh4aY/Tofp2uimuxMTL2LJCKaV+yxsFSVopxdZenxa4E7W6EqMSvje3RhQc3yLgfsrLLOX0Yfppy7MP00YlxR9QsvNb6KTJenTPpSRyuxPKxCyRrbXgXisx8G1aOhMwfUULFizVHxydEIuboilFUvauRDCczB9OkcRHOy/EtziJXwyWB+hxcckIQhewQlY1QjHwf5IWSZfiZ+bpEIqCL9lTyZhYDm+TCwkuC66G/GvBQhwTJ4Tj1khZLJedcZ/9MfBr7kIWcVfBiYThvlzwYOHp5L9lh9kYKUTF4lRhYbmyEa4R0Se1b6K8CNJVmLhV1kskxCyQvDp39qmY2G8NizhPSzGx/qLc3RgQt37VY0qPyZhQ0ojwSfiXjR/sj9yycbMTCHFxEJiFlgzgo0yTTfAvBe59EScFNUyUXB0/C+CEdbFx7VRb6MLArkgN+FZWWXsW5GF2S7EONksIlhHQhCyQiEkvK+iIjHwdaOvA+eDDhoXtcODmyGEooqyq9ghZIjhqRLAa6Ky5Iy0l/JqIyTNNxvKWCmfTayTyTFkt6ySvN9EckS9Jqdre2YEL+5l7FBsWAyWBJFNd+WKt0YGEkTfwRfsFmhRIwvohPRwz1GEvyjnw89AsWUP+ZuNk4HKEJiELw3m+iOaZZexsX3yorSq2YeHq5FFLs+oa2SipE8Nx8N5+nh8kVpiSI+G81mhCFGyMaItE0qtGpkMX4ZiRrPvOkzQ485ImaLJRrJMTFled5XnZY+iHWyitkqPTwUeS9mFGkas0YitD4fiw8JtmDD4MV5Lw1lQiiitlsUma2XlKalGhC2Ii0+GSjWS6KNNk4UIQhb0fS+2yjCwdSMaOngh1uUdTJYEkryjFzkdLjYuy6jtfRLvwdmF6f5Zo0owuFfjiceTUWymaTo1MU/3tfKsQoXyiUaylG0Sh8iYmLYtmrKM2jEdow+t2FJLsU4Rg+SZ6eFKx52LsTtC2TdLw+nwflkeB8o6XlWS8DyvNHBpFxmj+IiEiS1RyjG0V8MnCmJiYmJ+GXRDrfYlqlR0t2FP42XRiTvhbqsw/TX2QwIo0JZ6r8CXO9brNRe9WUdZIb+3JdmFzwSVNowTEjyabJwrkQmWLwS6Idb2zAj877FNn1WfVY5N7lHU+DBwKEqHP9Fl5o73V5L8C2fGUOR5Iw+zG7MDsxI8iXwOFk1pYmITELc+iHW+XPBFUvFe/02H8kVRKW1ZLjavZIQo2LCNKRwKhQTJRpkXUiXWSIdmI7ZgdkkmyS+6hqjFwdTHFxYmI0sQslkiXRDrfhK5eRRbKrZBXIw1SG/AnsQt62XsQkURg2LCS7HiRjwjW3swnyYp8lZIussHuxPkl+RIkYsLR0QfPJqi4nzufRHrczBVIvxxlW30y+4RRoZXG9cnQiheVZJCTIYPzIliKPERyb2LLCXNk5Wxd7oH+z5J9LKSJxoQpMQtiH0Q3duhcL2PpREY2atJSmuOxxaK2rsqzrJeNZJZQg5PgUY4a5JzbzrZGGonLjTERBfJJ0hbEWIn0hEjEhZVCyQslk+iG7BVysfsfS5XSGRtdCrFVfJKLTK2LK/IihROiGE5M4gqRK2VlQihEY2ycqVISsUbOhvVv+TE+BE+iKtUSw/gqnTEIWSy+CG1mAqQ/LCDkSjWeE6ZE+M1/o0/VX+yUWuGNZxKGJieSdeChISIYX7NL6RH07Z/i0SwkhqspLKA5aUJWJFpDd7VnEm+SJLow2YqpmNC1qEIWSyfRDbL9EeI1vWa2ddbcCfxku8sLCsWBRjYFocK7KJIsUv3mltvYkJEYWQwUuzhdkFAQzERNE+rHknRzIvSahvJmpojO+8lkhHYifRHsxehcpoap0IWSEfBDalciXgRpoXg9OkLKHZDswSPRJIxsOMhwocTTsW9IoSIYLZCKXCJNYf8A0nNtnp8S4kHliGIS/DZf63aUaGiMqE8lkhGI/gRLmJh9mPD7rEIWSPgjtwlyS8CyXgw5OLFJPKHZDswBE+jHbshia/tmSw2hwNOcdqKEiMGyGFGHMhNz4Rp0IxOT5MDhGE8sQxDE4hmj/ngcPlEZULNZS5eX8SH5GLHknHSIWSyjsZhdXuStkPTJmJ6dLlZrfFW6Iem4FGNUsoi7MBi6JmPEwvyMLnhmL6ZdolBrs0o0nWdGkUCOE2LCUez6qj+JCLxHyYWGooxETiSj9xBGEssQlyz1D+D4y/0V4ImJhcWQdZLYhfiQ7MYnG45LYtjIfjuw+JGqDV/JInHnJb/TJJai5TRVZLLAkYbtEkYsLRh4dMwojVoxftHoZ9p3ssWNR9eRqb7IGCRJInElh8mHEw0SJsSS5Jyt2XlRe2y8kz6jqnkhCHkhfgQ7MYhyS4lWSzWd5dLciGJwWYmS3dmD1yJJLZHngwpUYGKLklCz6RCFDMa30TFnp/2J7YMwpmHPKUTQQgJUS6GYuJ/FbNJwN/o52IoSyrch/jRh9mMYfZ6lViCFmskKJ8kt6Nb8MEYEL5JytlFZLjk75MLFow8cWImaka0SnFK2Y/qL4jm2Wso7EURlRDFI4osRMtGtEsYnjk8XK8luSKKzw2rpk8Oica3S/Rg/keoMP8j1cObyWSFkuxYiI/kS73Lx4ULL0qs7FLLroVMVxI+oaP8AJH6klOUuy6L5HkmijjKy8ry/4KbQsY+sfWHiltlbNX6y4z0iWd5weqBjHxmhZYHZ6nsw/wAj1EbMSNMWaFnD8x9+Nd7YRt0LhbUKxI05KzUW8uiRZeVl50t9FbqOiv1tSNOaywfxMcj1u9Mj1DuRh9mN8GJG1eSELZh/kPwLOGG5dEouPez06zorOLoUi0cFZp2YsnfBB32ackfO9WdC5zrKy87LyWWktGo1HeUT5IdGK7kRX27sHhE3ciHZi9IXPBNVKhC24f5D78eBiKL5Maam9mB1mjSVnyaxYiR9Wxzo1ujCJxIqkamixPJZJZ0dCp9n0/1lZqLRpRpOtqjZVHOdEckR5Z+MTtklpiLZFWyX2wyh2YvSIdmPGpZLbh/kPwLNd7cB0fOadHEhwaz0iijvo0GkoatbE0jUc5pfBVF0JWaSM3AdS5KRpKOS3sUWfbE1ikIaFkuxiMCNs9RP4MJGM+NuEjHl8ZQPUdIj2epQhC2Yf5ku962LvbDhizrJTo+2RoKYoih+xyXSO8kSVGk0mkoSKFEhEk/hFFMV5cxLs5RaZSNJpX7PtRqS6NTzQh5rskRMP7VY7nKyPLoxn8bErYvtG7d5QMcR6lXHJCFnH8yXfjXe7Cd5IWS6y1NH1WfUbORI65OJElpOGKJpNBoKoS+RR/ZKXwiX2ohE65MPnKfQuhP4F2TzRWyJElmuDshH9k53whGGq5JO3sjwrHLJEO0jG7EYy4RiKmIQs1+RLdEm0+vFgDFkkIktnwfB8CKI/wCh2uSMj6qLOzmJbkKNEuZVliMw8pdkehkSWcIknnFFZMby5ZCBOfwhEImLKlWyK/ZqvNGF+RjPkj2Y3RNcHTFs+SW9bFsoohwztWLbpsqsvg7iR6FlFcjI8HbNQuC+CCyh3eUuWYZ8ZJ8C5OskrIwrljl8HJQqRrSNaNZqEKNiqPZLEvgSEhfYrY3bvNf7G9iMPhWS5IdmP0VwTiIRh6X2SqySPjfWfJCENJWdZ4MrVFb6yTIyoi+T5Lp5J8CPkssj0SdIw2SfBFWR4JfrNcC5FCuzUvg/6Wkat8OXySWnJCRFKPLMSbk9l7VyzE4VZYX5GOR6KvgfD2S6Ifj41vXBCdlZJmo1GoTT7NF9FVkuBPkl2XwJjKLGQfA3Yi7IkuHshhWNxgqRbfjh2Pl5RVnEOSc3LZe7CVckpXzlgdmN0L8SP5GKqYs2YXVZrwLwYbp5XuUq6FWIhx05UfOT/ZZeSOsq4EImvkgirZGKjyyU7ysvcijSLDkz8eBISsc1HhDbffiSsk9K0ovLARjM6iQf3mOhbMLh0PNeww5Xw/BGVdHGJEoriySH0fGyBNciER7KMToivtKpGqx+COG5EcBLs0wRrw4ksa+EKP7G0jW35I/arL/eUeyHBLmRi8Row/yPULjauJD9mnXJGSfgwp0zEXyR6O0JWRJr5yrgguDFXBDsiJc5TFP4HLNFFFFFEaXZ9Z9I1NmlsWEz7YksRvypF3nD9nSMFXMx5GF2Y/47ZL5O17RcCxBC2ULgj90T5LInTMRWhdj6FwifRBHwXbMT7F/sXPe1Rrs7zhhN9mJFLhGlfItB9SCPrr4HiOXfnvZHukYjMBUmzElbMLsx/wARCFEokQ6oRCr5MSr49nGTRHEvLvKxmBKuCXY0Q44H3ZLhCVHwMfPBFGsh+yctUsrzVIS/ZRcUfVXwf5DNTffsIYFqx8bkQ+1WXbob0wPkwuz1PWd5x4Yvb4c742xdM1XleUpcZauCyJ/oXZOVLYhEYfLHiVwjU37T61RrfBWyUjBjqkeon8IRgo9R3srL5Fmt6XlToi72pl8liZIQz4Ey8pc5oUOC1Ecm/eLk/FVlh/ZGxu3YjD4Ricy3NEfcQlpYudizsvjOy8lshh/JKV+3veuMsOOpmLL4yijpE+862r267OoileTI7r2LPCw7/wCGLO+F/Qd8C/8AOOSMGNsnLkxVyJZ6cqGR2L2ceyRQslsXghGyc/4r+hwo/LJ/flFWJaYkeWYgs7zoXG2I69iu8l5tBKWlUv6Dow4anyYmJ8IvLBhzZjT+DCRLsooorZJGHzsXsoK3kvGhR+SMf2YmIuo++vOEG2TmkqjnFWL7UXqZhqllRWWhlFFFC4Z37XDyaI+BZQjbomlFEp379LKELJYnxHNGHExZXwjDXJ8ZUKNmkUikyUKKKHGyBRW2iivE8odZ6RPwIw4rDWpk5OTv3yWaVdjnfGaIRslOlSEYMR5JDdZo7RJZULvcjD0rsdX43lDofYnWVZrbhYdLUzExNXir21GqutsYkpVwskrMJUs+jo1HDHEh2SKKKI7q8y6JC5Fx4MGGpmNifxX9Be5I1frPCjbKpbLKFwXayqzSaBxKEVnXlZ2JUvEkf/nA7/pVsirMKKRJ5UUVlQsrNQpnDHE6yrzsjk/3ks+SLzwY2zHlbr+pSsiqI9Z9FvwpnZWVFZ14nl8kdqz7EYPCbJd+0r2iQuDDjqdjlzRW2iUGu80zT+isorjKisqKK8LGIfZZe9DRf/n/AFCR0Qi5MbWHEwvud50VlZDGvslD5RWSyrNToVMrxLglyMYiRF/DzW5fo/8A5/06yjFyElhrklJyZgRHlqUS28+jAlfBOFPYrFT4Gso8C5KKK8LzvJMj4NXFf1GHhuRxhIniOTIK2RVLOitmDKmYnI0LfF0azWJplb2SLyrKhSr+iRwV4kjDwr7HiLDJTcuxGDHZW1C5RpKPtNKZprcso872SL20K0Ln+gwEnIngfcTpLeiiMCMYx5ZLH+InL7yw42yH62UUVsUmiMztDR10QepckluQt7MQiLJZ0V/QLjo+rJ76EjhH1P0W33nFWyP2ogs7QhySPqN7UYbHEaIcMkUUVmhLKv0LNjkS5RHYt1+6rxLLVtSIKhcsVLKVs07K2IwuyjSVRqNS+RU+jTsW5mI6GR/Q+PJqyr+kQkRjXZ2QjQ+RLKjScLs1ItbUxTkiGLfZRRWUJWuTSVvskycvuzZEryW0LE/YpxYqNJp9jRRWV+JIXGWHGz/WxEpaTvvK/BhSsazXDyorfiPgl2J2skV7CjlCnI+sz6p9RGuJqiaolxPtLiXE1RNcTXE1ms1M58lCQsoqyPGxFmJ1uRWyDrOihdZUVtkSdsa5Ftv2lFFbKKK8sY6uiWE47FlFWOSj0YZazo/6Sd7o4bY4td7E6I4oqeetC53MxZf1dbsOekcvhZoQoksSuEQVsrgSyWUskikjUh5anF8GH98eSWxGEM+Ssr2z6G+clkv6VEezG+PAs4oxJMRhD2vJE8v/xAAoEAEBAQACAgICAwEBAQEBAQEBEQAhMUFREHFhkSCBobHBMNHh8UD/2gAIAQEAAT8QgehxBpLQWg/WSZxmkqMhESPE76ROR5MBSEKw+LR0Ly4KfYzxZMoyft8hj4DDBhgx8AwRhVCkMiG4RCIEFM4LGGFGPiHxDYHwHFNb1gAxpf8AHL8lpvJWeA85Q5pdOfgbxxU91kzLB25x+J+N+c4QPwtOZ0IKx4uLUd4ntPjq/wAjOMvHeHKfnC/cfIc1ZkC/EHNM8XMUyYNSEPSk/wCJlYqzEpUp5QeS+KYT/keNfKNcz++CGD+AMPgPgGBj4JHwD4RMI+EJ18FOwxTA+QuJOzK+Th8CMPVhfI65REgiP5HPbeXwOOODoQfobkVebmbuC57ZxWddW6AqZ5Dgj1n4QTozBplfE+ZT5DGfYYT+r+FRzjndoL8Dwcr+NCLfef2jBDBcGHhYRiMMCuCYND1f4O0+Hs/mBA8096BDOmG50lgCAu6rfYt1f7m8TC/h5zdjm0n+3rnFe1hosfKiZxZcrLKQMwaC/ky+crLiAZxx75UfhUC0JBRH8a5IKrAgVzj8Tj8ENO/4yjgwnL5MZ/RkVD7fnxtI9e/BquiCN+tBcwuEXdmYP4TJw+z5DA4HAMZG9DkxB8fb8LfOmSPi4OsaZHwp7DIpK+o13J3vNhuT/ELeYfrXO4Dr99ueDjDgMjuuCw1OzD4sfLkbz8MDvA/GZMruio3+MuXQtc1ru4LSv3aiEhQqMpcKOqaFzj2z8C45XwKOxhVALy+JPvCLQFVRXTv4OTv5HBdJTTTH9pjPuNzFEJ8vXV/5/ib07/2YI0c+cGa/BDJ3n+AI/f8A/fhWPwO8mD4g4i4kw/xanT4n3nkKV3IuIDLuV930m/oBhp9pwGRXoAxiL3Xrkmrb3uwozA5oyaC1zjFjnkzXVkTuuUSjK8u/PaKlwPKL2LutP6zjGaFN3hc8z68d169+oEoxEMpTRtM9jSc0aGXnUVInkyVfkRn5DicNCjcZylBmgi2KUb0+HzkHwb7Bhfv/AIKrXJWMPgp/SdjwDTgsDkUomtcnMMy3BE0ujUm2ugbLkaUZw+Tej4YwYHjumPgQnKGTdELo6x8Vj23NNbKmAodAQq/Rop/dnO/Jk48gE0LJ+C9mWKYkI8iYCZa85J5LgqBwF85u7oRzDBcVEMvtzqgyla5BdK5yZwbieHQOst5R9K5qtHUW6R+c0b8z0Cl8Gnk/iumcRiMRNRTAPMWtMkuRODKmAZR8XHtmDXMsy0J9hQfs6y+dY4Mehcw6IR/3OiPZn5DdvygIooROxOaZFjOva8ZYuoKLLHbqfLp9LF5CNEY3/wB+nKi1eYF/rJHukG/IaHs/RgenRdG9If1gDo/NDL6MSc4QHIx1QJHGZCsDgx8hmeDE5k4DPcmg36UED7XFH2s8bz3BXMEKF6Miq/S4TXJl++j4CFMqrlK3Oonl+Cn0uSHwq9LmhnFg5ypgZPQzkyAXVyPgeUzHkzHTMADKuRNG9RdeOzyLgKnmzPW+0yF2qY0RGQJoZAOtR0GVyosDXVHgyjIOALFcHMQvjrKvFmS6mfaZ5c+0NVLYmZpRW6xucuvfwEud/dFMYjqDEz2hZmD0/Ie3EOjZ1YwFmbmyJ/DpchnTqltz5N+VMTJJV464YuQAIzrz25y5W5BlK5sQTKV+CFzgFEcDwK5wC0GAWjRNDkGQKfM3n5JpATExEuWV8XKynNGccGM4u1DnIVazBxjvcL4P+uE4c2PEx3Ue4vRHyZk6hnENw52efJlhq5fP8GZfSY1vhO9GPg+D9M0uAqNSJ6rkFcivxPgXK/3GuDE+YP0YtUAA9s+K5uwmBN63oY+Dw9cUYKAE/Rmq2uMuQYGD4Z+ApyQwjIMTVTIN/wAB0LGB0GRcouXnmi7xOUKmeZt3Iyd7q5VcrLrhGQNTVTUKuZQct7yNcIscJKw4w40iGeljiZviN5G0h2GYgTESJmDJoDlzLw5WfkAEqcQqhGomR/IH21Orgio9sVlhpV+JgxjR+nwR0zRNdj8LNSlQAPndGLmGYe1oBR3RB47OoIATMvKoZqVOZrmTKtZHVPy9spy5Zgb04b7cqZbVDVUN25xB8BGnN/BTmswzuHu5HOTkJLjFbxaBgPMXMErMqa034Xh1cIyQFcsLfgx5adeDVq7K1iYf4TwlPz4qCT2JNCwpoZ7+E+EOENzNyZpycYwv8ZFybz+PoQ7XU3O+g7wDf4JhjEZPwcGN/j1OPqJu8qHBcbi8jxUKJVF3AmZHnpN3aRHQMrKb2w+KGF710YrrmiuUHK0N25V0quHw521TVHA524BgE+Iq3LLDKYlhxOVY4QcAUItVbzctquSLqN1AzI6vOSGg+NElwaBkV8Q1nRPy4ZUE5HyPs0qPly37Dyf4tEc2ALizFyjPfOu1voMKQEOEwGAPQdYymAoVz6CDg44vDjOL8OOv0AFivvLtitfR0t7XwMDl+Q+QCD84zUuPVJ8TDHGugAVdwfYNBTkQAhve/RmbMDfhJXAXUC4BgZOsSteXLDKDDKHK3eHKq5HIjXB0NdVFwjsy1cYZAdyaQ4VeVVf5nHyqzUNzWGYLXIBhlbgdhp6uTzaa8OM+APT08YJJrgtAYNogvJnIxFIiNbphAchxc/qJma6FzjFM/JJVuG5J4W6te8r9mdP8+KPpVyT8419AsB6ZlFz8hhh8URex/wC62RMzv+h8RR1pXwYaXlYYQobtiusq5oeXB8SNi5cwtcI7cKeV0LCTLn7dC64gFxlyZX25BeUd5edTpkmBTWMpnFyqoaTUMhpykdz8GM1XFOdXcvO6MI6cl3U1J8NQREFBHhz7Svmt641ctEmjyUjRqbjc3ffgceztXIoCxEjTSvwONHyFUlc56IbzX+k7/wD3WWYw60WiIlJ3nVDVVVVcqrgXg3JgJQeBPw+9EUnT3/AlXfmv4JD+qeouMwh3poxAr2qdq7s1yNbkruzPbKt3tl3blyy5G/AHOVfDodAmVq7lFyyrsyk0BjUatFMrTITANAPNmQGZUZuTWZYZ17/FKulMoOWfFNwuan494QksAXITns/gDLL0PPhxjAt8GtOWiInJmO5nvn5AhBwAFVUnG+6E1hW9RoF/lxpto4cCmILjVTSPm0F1D9nwWMmOtjFe9IlLACAGYW8TFy3PIOmyjjIrEcBlBlNAyqygzS6k+JlBuNgRvTl71p8EaXceETJz8TpsSdZxQdqPwEzIB+FHeguXLR1NDn4UXKx17yuV3tw5DAk1TC8hufxvwtYZZjJiJHhzxHTwagoX7E9j5HKWcTU4ysMtJpbvSuVGh6zjW1mamuHGXBmZ31j5Z1lAX+MUzqeBdLwwy8e7A4r31N0eo4nAhwHQBrrmUuW9rnILqkxAyFwhzjcwy7le7lynGAHJGiSKNLEzxCwOCENQuSj8JGH0FHCc4pnS5nCBmCan2OAK5ATUHIMeSme2jhVvMyfKZPg0/wDxGYcs5cpBRE8mhDOaMde49J7HcNBneA9AbZz/AFo10uMPM/LkrL4uLdnKGFHC/wAVon7kc6grQ4MJf056kAAGdUuYMobsywrjpGDncDqHwoGXCwLLZ3mXKu8kHFzscF0fEfbuA/Mom5z7nOyftm9d9hjyP9N1/wCtqvh3/wCndVX0rWH7QRlSmdgRH6cgPxSvyEblgw3Mxi+HEyZM4OPzTKam4/h2biIrveesgRGI+zHn8Vx9+Yuk8InwEwfEt+jmddnK4YYMZsFTDH+FL6hrGoPt493AD0XXWsEM1YhaZFZlyVnBN3RhdSuSGaV+B1BbyuRzlWuquTllDRdE1N+Ggj8Iz8r0H5XQRd7lte75X992u9jm6qytXHUb67/W9dnonXHv+sc/X2ZF9FV+ndX/ANz1ljxYSgbECI/TzgiTdvyMVnrKN40zRigmSM+Dw+JR/ifwMs44myBIlE512PXAX7d7xHBPXrnL2NEHkOXWgO8s9uEmF51UMRDxEKNxLC1AgVWGZv8AAGjvWcUzL1C8tn97q/z5ncAQDO2LmTKlWuUkPghnXIGZJ3TvWCu8GYFuRY575WrF11KDlTPuKYOo+Aw/K9Bgn7/XEKfogauvrxw5VeW3tcs5TqdzlwdXHQg3QLK2/oZi85IoYWXoi+rj8HbUT1ZcI+P8r0zl6p+kMuZ40se6aFEUZXIjTILjV1RzI5D/APn1kPWKdF071GKnT+EyfPT4PgazOEneQE4QMRHD4Tj5HqxHsRsROxmt3yDFxQeLvsXIIBzfv8QxJg+Ls+BXN8XgPJ/vWkqN9UZJIHACQOOsyI4S5cYMsQ1DXuDVXPfRHF0Vb8AOXKAutyNzC6DAh8DBbsYa+wHdQifavtcxrR41YZlbK/Mp8TTh07yvi/KGFzZ9D0nTonY64L7Dw5ezoz78+5v6naaF/wCtO4FcmBN3dBaOpcgue0PhMIafNg//AAM0XAJ0fuKTM2eIMRE5Mf8AyxGrLbB6j2Zo7N4rXOGGZM/8oLVuG9JuCxkgDd3Lu4yq0QwB86UxluVruF0MoaldChXe6z3DKxceEFDDUORJ2iQDE1O+fGh4Ah16PgdoQa5HBofEadz6N9E8ZlyAbg0ZTU0aMhBaNG8kyIQZ/wAB9GLfpiPSenD9n/0824cLByjt7cQYBaf394HBorlVz8BTPwTTv/4GM/Rlyqr8EFhIMRHcBzjz6zpiIkR/OP4vi5G4QyV4FfQGCoBinJjj8L5tdmcUsPwHt+jCMkq8q9rlT2rmtbkHxdjvZkrIvoyOLI3Ikj1lDHJKp3ZXNGVyfLhOoGRKNH0LHRPtTKnhMBRWMHunX/mM1TVS9OkABIdWbmyubQutuD4AxVMNMJ0ckHVy95BTOOR0+KmQoomTZ7Dh8ugTUjwLyJ6TAxKvp3LmDaI8AekyDxb1/nhktinIn+PJ9IJn4HbzchvwrNQJpWDA9r1kSI3dHJDTJ3g+ejKhScH8SI1j4N2z3ynsCekzPxAAdrTpMm401QTapFyKL8oo4q/EzAVWEKr+DS+SfYeDI2uVyau3VzcI1sqZlRQ0XKFwlDNCZS6zViFxHdfGNMu+dtaAn34xFCg+1dVrrn2xLh8SfIBIipkguIfgMulhC/QOs/lJv6RnhenMfsJjo/S3/U3/AAv/AMt0Kn+wX+hjvC/QQ0+ZNwReJTD0il+AUTIqCp+uI404uumNR/Dp3fho35tCeARX5HDO35lZnu4aC9iZz6C0sWLnOSIwrB4NEuTTh/gK8Yy03T5HPumgWM9p+0ZUWFjoXmOeXwDQN4t4z4iPgTmsH1XdjAeo95HKDmTIbrXJbiTcnId9DK8GIN25kyW4DMsGdhA+XfnVMj2IG4Gv/EekPtzgYor4H0YkULkrAPy53nqTXYNtKstAPsCP6T4gy4wGuwKcg9L767MyACgqRX0HRzenh068rFT7WvPoMSnDlUr7VHJl50Yf0AZJ5eUV/tXOQyA4R1kc0UfsTNusIAx7b5HqPhPJ9ORIFifBlKYj9gTQM6mPpVMdTTF6lqiij0mHBV45d/b4f/04jwwCPwKU+B7/AAfjYkQs7v8AXvNiStQJy/jxp3k0QfkwfBhhzo+z4Chr5uMnp69XBGidOPxSKuJNU0zWerTdNqivQPOPUKIej/1a51YC5UyUwYmLjoLkb8KOwnZqIZ2rXI3KBlU3hzE5HRG96C3CLlHsMXO8bOqpTpD9ICGehEdACndYOlOVxmgZgtWina//AHdOxpyD6bZ7FTzLkCEbKnFPFxYQ0hjiAlFQFn4umdWIpSrzeh+q+AwFUaqtT6XkPz5xiQZk5GjQKHYMxehDxW6S4OcuY952LeKPAfxeP066RDsth4TKPYMaTA3vHEVPtQ31/R/rBk89JX061PsUywrdSCr5B1560d/SG+xncX0KOs2iXyDB+pHNkOiIn5pimJ+rvsKKPTPEkLFGenWpo0+Bi5xE07+Ix+Onx2qQGBu1kzggqrCZqXYyjRTDuZfkygYc3YCInYnSY5m7+dS0wdWuJQm7g5m6LHt+ouCUXQJTUP5WA109Vhv3HN0VsEqzzy5XM1mRyjVZHs0wacDGM0fIuI6m+iJV9TE2BRelc7L/AFYUmfPrPolU+tJOEeABgH9Cr5ciLardY0nKOUwpwvqj6sdA5WPpmTGXOAAtzUZwQ5DwqcXcUPgdPYDwmEXgXqTPfDfgYc3SXM2/D2YTTK0Xh3Mo/ZWYSGMvZCz7PzhhJlAgnV+J3cOU8DyJ0mcFQOinXoeDKN7FH9nOCh6Rh09vGT6+0zkeg4/EdZ+xoH8FJR3+EGO//duSnxTz3z2zGTv4mh8yZmpXFH4NyDppuP2HxH5k+Mb4XhHjMt5+hfBhBgYHip5zJkMB64rM9kUQWHHlc/L1Z9bwFaZORED/AImOv6cQ6wxu7yOpfa5clNxlmEnwHwzmeIWzCMcZkdXuZfp0/q/2EYKuIUPbmZcneo9dONSSRzBugA+wJf7lwiCrjIQnpE69L7M3S9QMo1V7dQJUO28r5/feNx2GnXMBzUXKryY6odfpueQoayTHoO4K0xUI/cDLXR6SD7i9akQ6ixE8m4KFPXR9i7krF/H7H8jh+IppeLwHLXbnjXlf2ZBmuOKFEcMJx6hnPuCihiJuC6L5KOJ0vXmvU3TwxR7OnDfzX3+cYy9AIoeRHkTrn4u3Lzlo6i5WV6iaU+eGNFjuTSOIUydgGAdn4U9nJ04qYsW2K2GY8pgE1gB5Xr+7iigCLmftrlNxoKVUNCm0whUq2985e9cLI6+PwVM0gQ1R9iUf06FrDQYF1xDQ6w+IXUzDQ2UGR0DSAuhyli4nJKJwCE3LeJmN7In2lh8b+0My1QUP3hMK8lLmIgGzt8/eM8EWcnvMBwgQA6DjMP7U7DwGlpLxRD6DLzCCi1X0HBciow9xAfu6Q4oXd8XLnbmvhJ5ynXXdU8cpD4ed1Y9r3/UwdAPtj/ubgCRRURH/AEPku8zK+vk/0/FJ9OX4UnpMfL778VNHoH0MYj9mH2C+x67tQ19HyR6cR246rMoy0QRE4RHkTqZPQOleDMXgbmrwUzVoZnwa50ZDoP0aZ0fowdCF74Mb3SDguWdBj1dkKq4ykMBwC8MyHpEzoXX5A65UAC28TBCWBFWMyCZkCyCADqGvXJQYY3WIz7x2uH5M4EY3sz17efomKuYOCdhlBbntNO1xMGJCpAAqrwAeVYBiv3C6PVxmIARSsBDgqWZn7Rku2pcS6Aieosb+Jr8rCEEIcaHnozOBVQHfcpf6HOJscD+ZZiHorcOSwtrAHa+JNLH2cT+AFgb1SNIayvyJQU8BEiHaiOcKoWLFBSyvq8KTSR9NYpCEiov4HmH9ut54cAQR9kF/Au6pH2DdQcfK4rrdPqP9gl/oO+76b9jVzV9Ks+0d3I/ydw/4VF+1GU+7hL9KZx374ge9IYIAgRREhyC34uz5Gfsn9oo5nYpS6tQAKu69Ju4rPzrIiiiB+u8/HZv70bzhun6GLmdPzrA5QunxKZNDUTfoLxdOLRgXkNURIVe37xg+p/z4DvxOMNJ3DfjpbnEAKqB7rwbpOI4yV5HNxwVUoGajERH6ydzikE/gLNRAe1YZSaDB6QM/jTpc2cZR24PZ8SvLnSCl4hqCsoPEduDnPTrafXu6cd15mLTH0ofxjT95ZREQexOL/WBlAxTIbRSD0wrXJZv3vEUDsR5yf0n3EITSM7VAP/Py9Z+QiIPI5C+hzSHYPSj6CLxEVNdcfzyz7B4L+AyhCo1BVfwrP1ZkW4dEF/4T/dfXIVU5QC2UcAc2JPPrPwCR0HgB6C9Gi14rQwKWeiKv4Azkz6AL2pQfyF9OaNnaK9dujJ+CABqbW6XgfylzQZeFJpX3yg/siYufFJL7Qh+djjvV3BQ5/Bvm6kgfH2JESjmy7dJM9+ZZ/SuaZiKiE/p3FyEpitdsD1LNeaE+wdRhk7vGH9YLozkR2Jj2DyweHv46XOJkQ0707PlwcBdSP/dYPcT/AIa5IcZSajmDOhfZoHuIXgBga1cQMypnNzFVdATQPv8A+vEpMAJZlVyaSDtn7w+Hv/nlx8LlapgrnW5k4ytkgBX1in7eXPoGH9rqfiXCx8/3yar8BADBux7e3RwzkfAdFoopDmJ0J6ZMmULvAaJ6RBwuu4ek5QfJeTcU0PCovSHKPnydmKAAAxIko9IjRN2rd7SYifiqYvjVX6VKezrC+MNToW+v6NRDNdxB5X4HoCruBZ3oO4vt7eCZkKEYj40MAItWZ+8GAR+UQ66A3YdtBcHnkBVr0Xv+jAvcoABDkEUY8KKXByBkBlNYt+guc5aPYgr9vM/WEgh7Ir9vbrTK+3lMyZPi9umgO7MHXWOUEIA6ROROxMvYqkEHfoG41CgoBGIvhJNLyrL3L1fvKD40HoEP9XDmP0N7ljHSWKH24XwdebMPY8mLsQPNu8bj7+w3vFPhjcmQydny6cOkzn09w+8/4YMfAhrOhhGLo+fd7irXJxQuQFz2xGlESTsTOZJJCD/txm9RvSz7A4YnA9QOZ1AcAHAHQHgy5qWmX1y4qmdW2Ho/K+A85miaTtmA4+lTtFRV3F3cuBoPYx+zE5MQoDyC8nGLuG1fh6dCpY/u6a/LwfboXs7IxS8T82zU6KxiyeE0003whlX7X7FuLgSzoROG/k6cBthoOlUH+t2OS4dvgLgN/wC6rAGBzepelcH7mGE8GCQA6Jip0o4lyUoV6VSrp5V2Ah9CofcXS73A5T6rx/WFtVVtcJQ1X7Xdzd4O2aS3qCL9u6jDShoTA8ZIuQxrHIrqSXGE7mtg+Q7L7eHGK8fW48eWIU9WKr77JW7uCcH2PI43LHCYgcgfqH9m/sMj9befD3WHXedv0+P+Zveeiu7N2biOe2TJh8Jj/eN/uP8Ah8L5C1D0cnYETsg10AQhAgTjXUGhlUeTd2ILqC56Bzx1OLFyU6biDmrJNA46DBSuEa71E/ANxdAQvvsDRJe0sI5cGfk18RGD8J0np+/eoQkT2r0+j04kipXDosLOR0VYvApMZUI8lQB0+/VOTNjMdg/Yd5DhMesdUdcIDGN3k+L1RR41HPr0d1fwGvdguU3s8hcYEiBaqryp2qg4nxPAI9KifQMm4UIpeHsbLQMhUAFAg/FeHBf7FO/fBmiDB7NS5mAbib8/Y+ny5lCvu9uh8RWT05Yad2GV0FnfDpczyB7RCfbwfvNwL5BUfoTNsPzEZeA0xMATsRuK8/4/UamisD6REf6UMPYwe6vIP0iYe/hB+wfRQwPqcH0EMAuG7l8FLoOTuv39Fiv3X90YWqJ5O9HsPh3cmjcClx6Zntc5NH4df9Df7j/mP4JnO9k/9wYOyhquYV12DiK6YpmSDmr2RyT7XcLzOMduXFcQXphSBZ05MzK6Br+JIYGQBg9qlLqVWlB1ECmF+j4qkyRJj4T0FEeER4zq4d7H8XU4K9F/9NOEMVYvPZ+eesQ2pBOzPBXsD5x+V9PZRchT/DOt9r7NBa1FpKgxwu5wmyLysOYdus4o+dmA9COlMKUvK3oULyxmPl1ED2elDuEDPP8AJeceiuSJRgg/Wtf6Ljai6BKfth+jO0AK14PoMHJJQVS89C9WBnqxiALwodew3OjGZKPwQ+VcmKv+B7XwblAKiEpfQdv94m94h7+jOJ5fE19hIamZ1V7wdHHLtF18736N/wC54qEQPDC6j9xfMSBwnI7ku61/kMn1Ofmb/FOJLtBX8vOr2n7J/wBdQ7wPWOU6xTDFevF4y8rIFcj4cYbC18U8GCsABziZKad6RcP0MJ9x/HB2IjoIqgPKrMXWIK+3twDTXu5mufnSFtZnSYgc2unc9ljkahEQUp6c8wwE+qZa/rmeG8bMIgpKCL2ALPFhh4a6mnYAinjrv2GGa/75gkaRvlONXJMQzRQpBwn6HqQxD15PxiVqVW09u+Tr8kc5msIRep04nJ/W6Y5hfX2A6D4TFDZsOFOx45EARZjSiVDwN5D8XrNAKQLAvavrjnHEpIuieDBy9QcCBr0OAhK7FLF9Vhqc7gth2MgzpBQwg2coC+gw7oLE65FWq6yAGkEEJIE5W0OjBQ7aWqzvrnyvtmlgDwBAO+sF8sxjA9U5uIUoJw4gm8Ae3CDTyQj+SnRhGX68E9flzYHghX7DxmT29q35GH9WbqORZFVEAcqvoDM1gz0Br+6Xr3/wxG9fvU+BE5fgIGL2i/11DqX+D/mZ/p/7gHyaj4b/AHXrPzr/AJlaBNcwq5Tc5MmTvE9PD/uM+8+A+Br6W7X+gxJeDAyL8BJdahkDXusMoOZu68XMHblodmb0B6XRO6xDMt77/wCRhl9KvQqw9whnhA6DoHAHoADXCNwO/YOXnJDL5xHhE7HIsdXtU7Pcti4hHsSL69P6hXAVOgoNI29WcOdMrEj1MRduB6RB/uphImx0oLL+MBKZBpR5EfyciZUl9/FMIje3FSIX3x6+h4KphUXxOCukzBDq1PycDh8cwqxXpfwdp51gj5jALyl47YZTR0OBRbQC0lCw7lzWPdiMbAjb7eDN/M/b7sMsUWKPYB0ZqbMJTysMoVTCTRCigeBOFCVODRVPUHtPcwJyB7TsLzMQ8UAgUFVYCtnLnB8lqfWk04O3QgYN7OdMJwH4jwr0GSwdAID8GdJ4JTcF5GMMEB8BhAK39HtfAaVOvFYjoPMMI1RwPhzVeoN/akzdMwvQhL+UL8YQPwbzzy/0Zavwh+uNb11/mEgVFD8nJ/pd3zZ+yynLuH7WbnX8MiiHgZOaRyFwy1mKZOHJj+t/3GfYY5P4I4O1ThEW7454xhu3Tyty5JzclumG4daDCG4E05fuuFvw8Bg9MPshjVKIftzKcLQyiWj7x8OGh6ByidrlPn6TteQ/Ccj5N5/piek9TwmVMU0HbxZ/fJpRYgSvYusoIn2K8PvhxywqPNFUfYDiHaAKqURBYnhE1n+WAku72J5SmTySrG0Xt5PdckWHgcwcAvlDi5JVUY3Jj7Uh7rmEyMPpNBnmdh0PLhK5DQ5K9t79vvDCvgFQHQAIGLrL0I30LHPFAGRwKK6F3Q0qfpKq4ifC0Eqt4XGiBjUNfCQ8tivxmRFBA8O50Cdr+11Hmv8Anat6yqEo+Kew9/bmlVXlXtxkexLfGpSuak6OuciBzYYka7A1epQO18h8B5Tv4l4bDyvXvlfHGRD5rG0vB3AkXJfWr4/R0B1MML6w4Ljy4yMo/mFc6/ef/wDuVD/uJB0p5N/0wfm6UzSTqA/rqiT1iXs+z4k7yLfgCGTvGP8Agb/i/wCGD5sa0HaBO64unE+1dDzJKZE5Gmkd1TWXNDrw/CgzoE5ZVhz7z3SspUAZlNw5AXQVFOgO24g5FAHCDKXnA5uYccupaM+rgL9O8ZP+BUyr1OSuq5c2s4ROETpH8OJKA/EH/KSzEwixF5t8ZAdpL3eB/vVQRgDIJEGeOcvyqoIBghW/kZmIph9Hpex04B13ojY0JZyjKVKiBeVGNNOw4cCKDYKKKTNUvfX2+8Euh6XZPasAyZJqUStAWj2BhLgDyCIoiMlEjMyjJYBh3U7s83HNUgZ++0e0MVUVrYU8pRX7ul0wD0LwcSr0tw7gyHikRXsppvvZi0Hogv25q1NIJD0DyUirnSkOgO5695/SDnwvID5XwGK3MxUBPa8HoODclEcoqNRMLb4OHIj6aer5/rI/YZWnrjGXKwPtZpVo6vIfB+UwVYd0EpnPz1OA9r4Pty78sDFnhfB/rhS+iAAAeg+THx5dFHmYgvrPlsJS1f5b/wBmXwxk9Kq/65wx+ckf0Lf2S/Ypi75a45QD9nbO7dhkwwydmD/U/wC/OPgs0I8h+hhSwIDK2pTTIyQva9aSg13N+AGR4Mo9+TKBe1z25OXjNGeByTafB0HFEq3iFrgg2IeR6HtMoJvy+mf4KuA6kSicxxEzFAFUfAD/ALnFVcSxfwv9CfmG4JblnZVSeBQTPMoPgCnX0LTF8YAcMEV8VSuax7iQL7Yj9gXEHCcLa+0afpz2UOzjIPvdZEXCHteT9i6wjWtcybnXuF9uli5QoSJqk6qCKRUhjs5mcXFXrhCQXuuBWAiQCARATgWLj7dsAFhj4gCWCpAePvnQlDIqkaC2TggFTtzf3mfyaaLeIZ2V6PX4yjPAqj+j0HlzByo1B/1faq4Yz8XISBJdMxDkFcvqj9pxMvbA/kTp/U3bkKHsX8zTIKZ1VX0ZxSD0hr9KcDhlo+0vu1K/lck9RePuIYcRPQad+mar+B4T+iGUeeu7eSvSOSq3ixoezmJOh1exc5ied/XA/wCuSlIlwef/ANgZ4nf/ACTNEjE/XSm4XDhyZMNx/Gf+m/4/+H8PE71p8IjRdu7VdAGRQ5RyduUJTNcgtcjHPpR5dOVGRvIfHC6AmFKrnl/0xgUmdxfEJrXF73pvYS5zvbqewXEj+fjLRhCkThG9j4fW49+8aRcKnVTynVh2cI43UdA6i/5EdVOWAY5QEHKmIgEDslL770yAVSVOOhvXnrRNwVeeWqE0eFG9PC7jryCH0kp+RMAJD0xDCgUAgDwB55McohRL2ICAxcVDBUgPqq5r672pFROdVW+avJrIS8vl1i4xmunkoPTO33GGajrH6Oj0HoxjBiBD4FMBmHZOHvGv6RrfLE1Bn1oVA7V6D25WR0lCM9q+D9Ey2wrTkR3Wzj+zJw47TD6Fly4S48F/AuEfznPFTCT9d1z8lZee5s31ypWWo6Fy+xQEQ7EetVwEwY3lExhnpF6c+0P2hdIPpMXg/wDUOkU/9CKZ4WA4uERy/HDxEYiY5zs+D+l/0xn2f8HzHPseTpx+aepB81zqzQK7uzAtGFXIXdvMcTmHI7u4+AwBmH0a+Lp+KkHpNEUcMT68Pd7ANEfJ7O3MD2CP5jlP7/EEDymG5P8ACRxDrobodah10l3GMPuz+7lFPuWKechpadg0Mqpj05vLrFOgwQuhOUPYH7Osgz4oKqY+pSdqGZWG+fB+LrIFHQWGDr2BSHoOV/QZqZ0Pn8wct7hlxtEIBIGQR0jSfGN5qc7nng95f6EMLC0Ae3nQGe2fvL+IeWYAeV8BhoXoBPFB4PazGQiRKAnT5J5FDeWbgix4OOCei6iAHgAP15xERvTS/vxjCRBUghlyoYRTF3CBERGjpUVPOzrQWeN0BNBoRA8InDc6zl4PBgnxCT/WAA/tr8J+yH9XfmcftTR2/tPOUQ4NxyCuEXAriH0v+6fsn8Bi8crqUwBcwTO5Ino0vkwD1y76yj7c955I0VvIRifk7uV0/wBb8WnwEMK4rmYyL6D2rnq3tuT/AFkv9tZg7/Fo/mTyj3yomYD4L+jw7hOUj0ijjxAOZo0XGliF9Z4bqeRirPwevo5g9GI0185Umoaa2BgCKIQMpVAvkmm1cIyKAPuF0sCpzBXOPunmfo5XRT1IjPyUn1VwYhypXdaCwrjJuF6hhL0xBRUX0YUOENOul/kYQGg37eXQUUa33D+1pqfkYL/25ysaK8r/AMMLhQCEhxAKpmseKr7FeV9rrwTqon9Biv2gAZ9nf9kzygTYQOHrgF+3rGgx1BKPFOOT3WmnCctQID9ZTmJspc3MhnyFg6H4iVO0NAV7cIYa6wu7TjKn3j11+kR0eR/78c9i/p2wQEJJERR/0+Dgc4T6X/cb8kyF5B9qhiH9gprK5lS9duIW66u767iYPU3hj8MRUKJKn4bnMCqi4JrIdASYqdDOfJUMMZPNcwSA+hc3lp1bu210sIeH4p+yx0mHYwZ9guC5QZ43fCih7VHFJSTRi9J2Jp4kewEivyBNJMMD6xNnpI51GiARoY3QIOtdQgDC9D2Zry+hplSB6kv+uWqHBUQ+RaNUxFEkPxpG4PDHjh9lugDOoRmiW7Wq/AxwInShMaEcQGI5MrKOOFaRVEAKt6J+XoyVCNZ0J3Prq+XPdgqr0D8/gwNAwHSCI30KvUGIXZfPo1tKikF7AqfcMpPDjv6Ih8M4D6rB78BQMTp8knPlVcoc51El6UCHjsxWz5Qm91yf05BCkIB76eKYtFEdghf7qX8M3it4SPRRQfw5cFNde+JRyIM4eWD4P1rGvOExh3vby/ZTrPbOWHv/ACe77Ufqi7hHH6OJFwtDofgM+8/7pf6vhNF1vzAMRB0A+JjcSzDKebqL0OZViImE2U1Z9EnIXD7cItXUyJhfgdQAqsAqq9H94poxzfIVby/+JAdOHh29hebVAXEsycNPorBPwiYELH+IE5E9VOvDmCI5+ny/rgYUXTEeER5EdaXyYkH0YCcNJQYAOehzvGCCbwVdeEUYxEv2cbqTJWME1zPA0j7Ub9GWh8En+0whT4yQfha/5kpSZEUxjGWg3FAvSbpX1TDDFHe5yMa2i8g88pdU42iSD+P+uEKJ+KVbyPadejCalNHy+j8DjdEvW90fZc+zDLhSQRSqruGBjYQe1eD7df7eAQH5Ruqt7/1AZjE47LwFZh2+BgF6g0AoLKuT0xCWToN5GJ0d6xYUiAnmgx/sxxFflMyAV2PJOdTAWQF9HBcWzFIERFETpPz6xy29Oz60BqSPpQT6Y4eIpH06EyJcYy6Cd1fQh/uG/W35aP8A06h9qYe/Zfb+BO8KbgX4f9xFfrS5tgMXoVlzmqotS+gzekncPmGkB24g9K58Kru4s9ufePDGeSzGSQLQ0lGoyDb6kcvgOOPkcPvnvKl58YHgO1yATKFK7mzrLR/2hkT6pwP0LgN9vBZ9mQgtSLREeT3TtyFeyQ/J6Pdd5PcWI8IjETOO83TbHesF7dV9CSSt9XK0LhO4Oh7OlDHGOKsAJ0KTt5OF/LkxfAiIHsS+WI8YpEoJAOhCxxyV9CVtLDJ5/q6X3yAf0bkqV4AAeoF0dhoCih8zMSh7k0K+2RiysFzgEHYkTS6c/bPOdDy2ZDVDMte5IWcORe1nKE0mrYTt/B6Mj6/7J0a6FVXhrB7XtVVw9tlgKg/rlvUMbu+Et9sCv26eXjC19Axb7DJdVGhr5ABheorqsv1wCtaqZLvqQy8UEVQ9KkDHLa+VsX2VvxaKQe3lfwG/BWmUHwkuIgPUfd1ixVTqj2fZl1oWBKST0p1+zQJ8DHreGYQ/K1cpzsD9u9U/8lc4Lwjkn+GqvjHZuIIicOCXOQ+43JPrSOaqGH2NPNwAdJAF39As78q01rrSrpjlC6Jk6LwVcwnKsOC8pnMjhqF+FuAq+PZ//esydxhUp9gAEqhnX4sz8C5V7U0Op8pBlbDrJhx04tV8aAqQqUL2NETeOkEEnRRnv1J39pJfYmvOIwEvmoh7HjBeEAWInvhM9xOq5qfvOLPyHJqNSYjRH0jgO9ZIV3PM9qifjy/0adE6pAH5D/13Y7+1SV9OnFjFRDwQ0gLQwVXp8pZw8NOH8yaf9Yg/5XBRfCpPtQ1Bk9KQr30H0C6PpEOV9IodMAz6VrVSr9vLk9fA1j++4eaeTTjQgkB4kP6RNVSzot4C0fscxPWKA/T04nNmENe6484JADldH/yHl9MXXo9M150g95M2B0A9QAMtVHZYe/eQwc7IvuQYpckUWfWOVNqF/wCuspliLEfKjlZCUOovVfqAZ21AsFJmaUnvJ2V0G/Cp8UZu1M/Qldf2NXkQ63n4MLu/1radLe/8EXe3vgodFEP6RHABJ+knWd+TvDiexnfrMQp6EuTf4nxRPWGPaVrXo4NaPg0KTMyuWmZTy9aYlxV3GrWxyA+Cn8g6TMiaVdAqXI1ie09LkXyuY/Zjou6hpORGTVYVp0egBdeCYJBE4EMpEREMw79PX3AKqOxFMnXrB6M8J0/ZUwUE5sj9FB9N3j9NV/YHVv0eRbOnPIJQA6fUFM3uwKoF7Vf+zCWw9CWL+PLp4kKzUh+7yRIFQiVASkZ0nCeMCE9VM8XkG5JmwIhLSgLbIAuhc+2O+4H6HLVCBa8RFAJ5AcRMbUoP5UMoaekpf31PoQ0OSeKd9LQF8A32ZdLzBVX9QMgtf2NxytYiUcdcX8qT7Dk+y5271EY+1yf2G/bCj+yO4v7wr9gN/rH/AK+cWB7R+6F1unaXf0LgUpvDH/I/a5Sqio/sK4g17iBn4KYe3DAHkP6LCGOEnbBfJAOaZFyFlQJwQeEwJDf8A/zcVUxFq6Lopg81NUguZvSbumWvpmnlp4/ej52pe/P+PwLlhuC9vwTzqf3BD/U3/wDXkxzBfmf55zvPMK9PtgUw7JhA/J/3WylH54/ix3yij/XEKrIqMluTvdVycDKLu9eVyq4jz9OZkKuZS+D1FN7B8Fqilx6BddVdUvIE+nkyi4YwFbnHd6sX/cS33FAA+1cKj670BEESiZXQqMUB7DM+5lIn0iGLB/ch64mm7MUEHkoeU8xw6The69gBT8BmBX+0zwwa/sMoe1TAAgp6FCxued3cVVPcVyRe8XTj3Lza6hfq8ovE48NriIASql9lnrRZD78H0uA9Tp+SKud9YRk9shixSgpFPQjV8QNMseUPM8okvVh6N6VwDD9AB/QZ9Flw8BfQvLjqAKyduuB8UR91nGBI6xSqfnkdfgA8HVfQHnMg8kRoPsXky4FdjWHlEN1fVcDfuO5J/RH0mA0o/wCJp9CAPpXca1BoMfSQ/Yibyg04AVSIqH0LnvR/OHaigU6ImedoEBf1AalV9BAMfAMqu5dQqw3UM6o4BEi9oAh7/OVE8Ok6TCFxrypCkj7c3Ow+oTSfh/25METir4Cu92o/Qi0Ra0fQ4MeO4/g/3zshD9XcGOAO8Z9xjW9pk3h+OH4xmLsVcziquUucNIfhxTMV+D78mMM6jGOPVGqvauNbm6JfOzsbClQDtXwGV4AEVCgSh5X+gIqUpelj05Bddhh0hexqntOXMKE+UXu+XMTtAUUn9iLgIzANoTzwIv3bqWkMwDixUu6h9BhUbDNSpFEBaggVUKgYOL6HQdGdV06KT1LxD8CGUnfNBLZYdOVaP/zYbxzpiL0icuZXYKM/pRxk/wC/8qrifg7TOLTbD58gHf5XRvmI85VVBFUIKgWhWhSX7IYSBa8Ff+96k/Y+gDtXgAqrDFdLRuwUqxV4EONGVMs9p4ILpsjghadUVyRSQqFLRbkVOB0FFBVS9DwjdfebtQCeY04eYTAY4KJC/Y5reOIf1EBxyhitUfuimFUK+U32ITNvQkot/Y5BJB0If+Bm0zVzblDLtWFcE6JcSw6I8p4dOrEYO5U6efocY+SGX3tw84Yfk4GTIuV8teV+3F9/901+K1x0/CQx9An2eUfRlVXz26C307nfeluwDX8Lh7Uf6ET/ALo9+DCj8JjdvnSmhl/fipzy58O6uUHOEuVuLkckF+S3whz0H4ex19sH+qaevKfQGLWFBCBObCaJW8HTw+3t9Qh6XBuC+h7yCR/k6knx7AvkRCp9FDAX9S/sHeRlaDP9LUMxZFTuj0AJy+1MyCcsIEG2OF2amUUKJ+BFyVvuUH03Lm5MBEIRUQg9UpnNUiJSmte6vdc1fXoCFvaDr2NwPwla+qMxMfUoKV6BRlusE1D2Cgh+AMuaPcoTA8UFTYBF+nIOVASUBUX8r48YrRxBVAWr4gaS0wmRt0oiDsDvWUek9H4DF84Y+2v4JuEVXh8VOR8PGQIgHgL0JynVwrMfCo/S9Z9Z9XsfyJw7grQ93HT65BnQa9kv7mSqC+gLlNNzQR7eDSlXpOD97jgfABjz/gHwy5b3flP1yP6pikndxOv4H+mYl1/L9A6eDz17bwZI+g+xwqh0Q+/G9nv9gJnA+ShLyfbnAZP5DgB+j4JZLDD7f91c0vtt/wBw9yB/rBWyGPHc/wA8zk+IF70T8CM+84Ofedr8MGXNrB3JdYfi/EOPT6o9UTIXt+2hrM9oWkSl0CFEOWK9/ZGaqtx+TmHnyHXyf6mEYe7kZf8ASKaCfXDXyP3rV/rcaQNXyvhdZjtDyqo/00cH77Y9h4TESQdWUXhbRjBpwYt4KF2A8I+zsTIiEAHsFIo9n58dJqmdQoAIRKbHtYLiGBUkg5Ok8Sj+xrV71AHtvRzxPcvqumeK2p4HoJ4kr/Rg0HoH37Xy9U8HDiXKEFQncH1S3ziHdOjtw1x6cOa0hK58FbkpFpnR2eWxfJ0/Z4zQG3YxPsaZ5/55p+xp+puQoff/AKF14PsbP+Y7X8kX/kx3l+In+ubgD0qu7NZufgYeDQtWQ51uc+D8qwxGFiB/IJiPqoz+9EBshPT2/wCudi1CD8Mrj74PxeA+1zxW72B4D8Bg80KH23G8o19tXHwYkBbiCE7HTOZ+b1kOq3/8PoMZjsKhN6Tpf+OMfbH2QTQoCFE0lYVv1kzpv73JMeln38KUy/C7kFz7ySY3D518Izzj0g8j+ExZpD0Aov6UObQ9oH8CmkHuh/ZBhF614CqVypS5SuOj5r7QXPGsPYAYDaRAv5dGD0g/VmLK6EfT/iJ4cSYXQOr5SZgINGdJ7H3iqyAAlaeExSg/E7HN0C8C2pgIvqdCOTRY8HXL60eSSr+V3x+XEFq8mK3CTgA+g6D37r2rUDooPRclvND2GVSpYBWvRMUZbD2uX9GF94h9tV/xwDyqwPtZg/Ur9HA/u5OJA+yW5rIgjW+oI5K76EgYMelMICLT+0+aYbfnvAd1g16HFHtnP6clPL4PtccnYqu+egPrrDY8HX5fBlM+SvLpc49ToOVXA3KR8rysjFWd+AyPjQj1DdoiYMX4kMSvK4GU+aH5rPYqv9vBg8h9TAGGX9Z34X+8hbPqGCpyQcPhsYj7c+clly/BwO8MGBFPr3gnIgDwteTLq3VL8Hw4+V8CbhEMUFEQ/Co58FLaPsUT9mNSUFD2ioH2Kbmg6ez4QX/x3blSvOYV/gQFSo++B0XCGUItgf2JiVySR/CWfsTErbf6YakUQ4fSUdSa2L0qUxA0GVjCjEctenD6V4L+VmumliWIjHEFkj2R7OdNajhWuS8vNF4FQXFaKMH2VwOKyD2uXq0/9Xx5JgiXaT2BA/tmriw+65d7a/wmJMcqo+ub+8PkLP0cYu/cXoRZkesV11LpnUf+tOjSK+iPwM64S4XHOZbFzzwPQVdQ6ftFn4NStlyrx+Z5/AbjeQ5BLF8r5Xx6xCtVbPLvXhwB0YpoHeIB7XHVwzwLPL6PRimUAFX12uNh1+48D5gFwtQNJ6ZAPRqtcYUGMbsf6Dc2Xpqhf0XWn7P+6HqfowhVjezNA0woA1GKB9Jjy+jdmcjkd7GM/ONJBy1Z/e7lGAppZlqu4VElBJT3or8BcJymJD4jTrOLmzYAHmPJhepH0LyfY5xDAahQn/eMUQCSuhWjx4Uj4Fyuhh6SJ7H6eN2ZcRLhKJd2uDCufImANaof0PH+OMskvsCI/qmjvrD7E78UsPtR/wATf/ujA49wc+xyMq/fATcAu9DUI+0LulLg9JU/q4+lgfZcN4kLJDmh+gyn+ofZb/zBwnWegK/+N3SIv7cHlTjrYX8ieP1DJReVeXPbUA++F/oyYKu4VwD7GmQmpVfatXMwwAN9u0+sU4n+nOQt2pT83/lx5r+p/wBdA4aflf8AhM6nO/Fefy4a3aykL+HJdIK+1mmDXvr9L0YCUHg9X2vl/LrN0PFdQ+Bp5XVM0hSr8ACutUAdrvWD0fwYuNdjfZOjIvXU9qq6xPyb8v8A+OeIQ/bnecqvI2rCNjEJ7z5zgEr0HN5cePg0QkNIH1pkQLXFtLm3GLdD54BuZReso1evmGI8Ofq+7Dtw0+gXXqDczy4e5r7uF9LDrU9XGGE5MgKIv0JwOhw08kjHsT2dOJVE9oIDw08k8bmDiEpVDqvlJDnkDTz3BJ0nhHFTRMmPfMZLJn+kaP7MaiIn0WK/WnbG19ijLYox+wGbtf8An3cFY/CKby4/rc8RRb60BRG7+DRvya8Nkb8uXVPqmugG39z/AKs4C3B7DzrIr9kIX/BwWY7Dd1e/aE/QfKSFc9APbd0OruGXeXlfo0MP1Ur9h4wxbdkf+rlxqP0cn706uB5+2G8xaD7BB/11aEQjALyOqCXmOsRCoyZOg7Z7cQAhPgCy+kExRijUqhotAdHCJ0mD1X3O/wCue3PGAApGJQTNcWu+0SAduPwWp7fAe3efcU2gfEGuqhrDwfwhoPsgYykufhDwf286yxv2G9zB/Ym5j732TBPtG9LsL2mjurmL8MdCI782jJDGrjA5fsdLKtzlwZGKb/CjX4cGmeIbvR+v65H0+MqGhCoA459oAOArTyI1j7Ox3kwnpjmyiDwZGD3SVBMxJlgmhUT/ALkaY0cJLREfIj4dT12HcT/g34q/QBit6/asa+G/Ckn2HHP1/tmFLv8AiJscwCoV8PDB2/517zrs3pfVL+1c7Si/MUp9Jmd314Ar0YkuwfYENWxQTEPEe+Nz8Fz0B9voxyXlRQ9QcPOZ3NNe/t8gf9XIFQQGdC9V8rpiWRRwg9D7X0dGgeiLrkgHsBh7XKCBR9gFC8Ipw+QzlJUA9tLoawGvnv8AO4NhYnZRHESwM9XNFmV6APoIYAsrgffoPKr1icvvyfgHYabJ+BDBT4MvRqcHAdBp38jdDlPAFPQYyJ1HgHAfFG73Qh/QX/uSV70iLpv0sDxoi9ppk5fhZTe3CD97nBzrpK5NT8G3cK7t1c5WmvwuHL49dXf5eUOrWv2ZsJYylOTLNi9oZ+nD5KMZwwI1jiyEsVsLYvAvi0MwehN6URZ0CmNFeC5A5SoGOowFWIpUIohlYgOI8C9h/ZlkAKJ0KZjpfaYQ8gcfEMoeyhL/AH3quUYekIj9dj6ccQPSUl/aZ8iR7M1L3Byxz8wjCE9TQqajOxhbudVP9o/6YPBgCgkynmKphuP3qPoi5TCqofa5JJJFPfa/2q4g/v8AQ5imgcnTTrkR/CeUeAwKcXSf6471vPQ/kvQfnQYDkgAHkvQeO+d2hZAdvcvQXtwYGM4gPgO2+4q9Q75weoBVtqvSta99FwOAJQGqURV9x4nW7aQgToDgPQToNzqVOvV9/wBdmqauR01BWcJpJm+hcIXS4QiB6vvO5ZqJyuDQRB6eYff5cze72/Aw+tZ/gAwYCHlkMwY4U/HYP4O3Xg6EPgxB2Qa27A/e56nb+inefln9J8Hr4cN+LgmT1kNIcbkjPDk70vHdwBjCa2vwrtP4WMyypkxFE8I0f/H8OhPsF2dU9g9jqXpafkuLkb7wr/RFy7zQAjahM6MWQQW3gwD0wjoOzWYKX2BwM8J5dz0qko2IiODw/wAf26fswGOhB/KawXI4OsoPimf4mjy997SV+yOLnf8AI0TDjX7uMqUw00cYXgiigvtBTGfIX+lY0GA+pxrUhe1Ybzz/AERH+4vjgU+mplsxXAPawMrqo/sOAUPV9m/4BzuaPOpwrGnQ/DUoAfjt3F04L9B0vlfR57dar8YOX8D39eOsrAATjyvb+jW0AL2oGWS+2Cv9R53KBZwKL+jcLApgEvAV9utiNBWfqftXUOSeEG6QehH/AFhnIXIoV/bOP6mZsQe8NSqWIMV9LuMGXg6MvKfBqx/CdQGU+GUEUctfaejPuoVfgLHthlsuW/jgVzda635V/wCQx9O39yRqL3EFTJ8JB+0w+CY8FHSZ+C1h3lLk+186RTtyA0jKtyw9GVm62/Loxj4uB0rAbDR4Q6R5Hw6ldLRhT0h/icOkOXHxiQ/ocPpygY4Tel6qWjnyS4fgARqqKKerMQY7oSDg5yJmriiKPI7ju0g9zimSQ0OXujwn2LjvEWHc/wD8iZD7QQdJ2J9mb0XPtGn+hi7cZ7iP/hvNSF9I0/SZcCZ9jpfsppXGh9C43ACdvsJj7N/7B/mB9+36VMaeZvqxGDB7VU2Ab2RPCvP+UydsRAVoiAHU5M4pcYVutFKKPYLM/iEQX09oe/eINz+kGCHutA5WK8dsIEYnIHmvtfPrOp2FDQ+1zCVYsgHovrEhvkuU+rVfwGbEPkVf0Z4SdgHBgMrUWMr7OD+7irQ9jRXxXwfVcSIDo7f29ufv2qu6EWKtT5RAM0A+ByD++tLhUoEYeL3NzW/w8OD4DBi8h2Gdv8Do+DS85FwBkJPAB8iCCnU/ONjxBfRRX+BktO+B9vBj6xQ86dKPYYEPhXw8LhK/gcM9jZgpm1zruGuprV+KGIp8DlY/hq/B8X3nmSdJAlE9J5PvUeVa9D2xeB9DrGHB+HgXpR0l5PToRaIci9BOF0R0oeRJQ6+ncSocnAfs6X0vfPswSIAiHj+zdz2MOxKw+xcJdpvZ5I4kS+wcPd/sTS8QAJOGon4oYG3ln6ijm6Yj0Uafswe7/wAhiCO1D95gdL+oE1n0RPoFf2uMjQvsLH9gZ+5kL2MT9kc5JQxD02uo3oSUBTwHtngy+zyAAF5e+5AuHGtOlDp/IJa95E+TVAAC9AGJcUAroDgPozltXQrRfx9evN3SJLFij16Py/RoE4KDA8IOa9zh6V3Ok6gAAegIYAOIDy9uldLxFfeUg/g97xIN5A7D7bXKIe7Pd4R3RKPcBf25Yf7/APybh1a4DD9ZW+/4mDGgVfgJVzCKsxceY4Ho/MytmCeTchQHt1PDRT8DC61S5Aug/wCy3dQIr6DCvpshfdTMH7DCUo6nmQxKxnmFy5UmLCLNgKAg8l5yCjgB23OWqty+FLvZcwIZgPwFZkfY/iCy+KeiRZw9D8icif3ucxlQ1nQCgBU78i5y5YFHpAOTwJhszqzSmBE4R4R1aJkIlH4fXswn6iiz0PPvTZFj7K/VmWnbvRDVS9SumuHWOgF6vQ5REwAIInCUHB20h9inYt8IscYOhheoQX2hD2usefh6uv05Yaiifi3KiaACij0l/AL7zYYD8JV5fyDNZIQSIB4N4aKBiFRoMEJhEroLQKvBKta7l/XQ7lQDHF0A0oWK9qtfShhkFRgAVUL5Yc5BAIUNDwT7jcqJgcAdHtxQk6x2flz57S9Za05SOUvlconOnkAPR/8AgOHMx0iP21/w37Yg/wBS5AlNxMUPwdGSmqqvle3+AP5Bj4WPcj29ZWSvteZ+XKHwaPtq4L4hSVPXGsqoavGI6pOh8HxN/wAvvr2/0YRFgB/L5d3JH5CsrCqq9qqusXsY+zN/oHPLEdXFzjeIdsZpFh/pwFHNBVx3v48OoNG1HgYCOnAvVMmGHwsbplnD4rL0x8PXPjnP/CG6az7Ok3hRPL94qjq/z2/e2xM/NcnZ7CCcdiCYW6EeHoEbkFobTwpH/Ryvw33ICvoprVWv0SpimRKFRRUJCMYYJApSpDqLwpQz9nSljyIlTAuv9wOUDX7LB9V6L2e8+k6HfSEXvgykEKndUKdwl5zURFoKkGDBaBLpfsa9CDwXwOOYqeEK1EVCHgOO8OXEGGMoo6AK6zriQRbXrg78dGeykgfFOWfgZknon/MBQj0XSEfhfHqHl9HjhxbBV7Vfc6/dzpD9r/EP/gGD4HMeY8U9Zx/+jdKqp893B2oRClPSdbkqVM3JocgdJ8GMvQjlPQHKuKjAg+jtfy94y1ED2rDMnDfmdwZvzA5SXoI9LhFwp8KJb8a9ISfRyTupRXMGPKwGXeHXdFOHEbD0Z7T41evB2z44HwHLPvPLLFQTeWTBR6TGeqlOU7Q8npyN0hB19jyO5LyDOk9I8Joz0fGpydEvSeDk9Bdyr+4Ic64flyhmHQ5dkK1+pgkUIxRU0D3Fz81sPtCKdUS5xDlH2MjlSCgnREwIEAD6FK6mVfwMRkAGX+gaE9Uc1pEl+1rg2rg6FPJfMGWMs6IiJQ8IKDlCIURnZD+lxBhB0HArYvtkmpGwgC2GO2CPCuQIiAlA+295099B0HoPB/LjX+BhmofB8JQIe3B65NA1o68RwB+XU6N9vGi11AQyJ1hhTgB8GMyALgRuMWfubkJ0O4Lk/ZUA15Ck/A+BCPAg+jgyK56+EGYz0sexy7UI/wCHIFhY52/BZTcD4XSHZl8e/iolZguMfAd13l8zw12GHoPh7j6Zw5/5I3mvQq/syB5bQA9icP2bp35fZ9qZ1Qpn1wP0GcWP3xhS8UFMCCGkb4F4cZF0KMEEEzCPxg+jh/RrLnJ6Yq5VaKMEKA54BQElckuAEMVVqH295okFb6YqazgIF9wIftu7RALbGcT7XrCLKyiYVZ2+CcTxMUEzte3zPxmNo6v+B9u6gJwfIf8AwR0YnsGkgPtyuMYTuWegqF7Tt+nxiOYXx2u9AD0OF+34sgXcuhR0OZeg9HwbkYMQXvoaqq4iVAHtXU7RN0vQ/R8XGHvpPsXEPBEzqJdBflZF0lw9OtnZowcg34edO9KufOevgwYPgYMfEY9/wPOTf+hF3V/yctbXmezax+BV6/ZEmHjZ77eIfq7O6sMU6BAonQt1L4Nh1wt1aAH6Dl9EQ7qae2Z6hjiiQEIlFrPPfOG28aPb4Mgfa89naw1tUyNeECsxLWi0CBKKnk9dnSDkF0QF8BmHpVfQHauB2/bZ5f4HwfILjuYYNLOAD4PgwajgzJWe5P8Ah244H6hf68GRq9nIeMMoYnk9LlVVV+enwQwXAqDlwzlK+16foZfFapaqqqvwJryGDyIPyrgNdPbTCjo/C21AepVc/id8iy/k8n9mBQmu4KZe965MveTG6+HGlHBgxgwby+c7y+E/mYvfuNaV40ftNb7nHvG8EV+lFiZHv2qpO4CahyEQNpCTiR5WO4+Xsf2mu1V+4GJKFh+7dFIYVfEz1Q4dKdL9GSBQ6rgE7aw4+9OOFPIZ5zWIBEEa9CvM7DiesgJDkeLRo/edtNA3ppqfh7MZCAU57zky54iPF8g9ZWjo6viHj2GPKuAqJctOgPwTVeVv8D4WgWejHV/rk/vrEMIfDyT6vBlF+wrXKJlxR7HE+g9XqzUPgr8GMGkLlQQeuB4+3EiDydAfj26bjNxxOT9dH9uOQFaei8B+AhgA+hf9c9nSjpgEkEECnVOviQ0vpmcMQXCCiIj2N1ljwZ0z51O0U9HGvIoItqdumi/gP8AwYP4Ckx+J0Mv/APg/Rno4PkKfupnD02tIhURPCJEypakXp/M8OfGCgOrbTDnn1pmfc5IKBshCiAjekzjRCN7IqEQqUelY5rEQCqtggFedRbpCiyz9Wu4pXUxqqp+CyYOSSIigoICA9xVBKrkPyJCpqUiwFUIG5DuadCPBBQNEoohWeDvLZScr+8mPqH2ZspxD8Hwfl8vZ0ZTqmFvyO/JhTVf/AIcIC4ByZ+Dl1lH0rf8AM+XDoAhMCtnICIM3ej245LmcDrRA/HbvytV+A1ZMfAg0hdxTByngD2uOlEvQvquHWMa6Amab19/YL+O8h5zA6AB9GTKG9mefWdPigzyPumeiPDgJSLjFwHHC/CXTjHwPgHCmHwCY9+MBhgu8WLmLPrkzt+ccsMAj/DFZ9hOkfJ8X27/qv/5qlQwQkPFASLwBkMAYHlaJDwUt8MMo8AVVQHhHwjyZUQNFIhWZYCE8Om0ReAUYe4VV9OBpWpIU8JuJUNAOidzI6EIXlXv/AC45QKhwzwe1YbkKq9S5Q+gB1VVWr/I+D+aolhhgXQuoga9Bg6g/nt/rXqD2MZrhB8eX94TyQea8roEH9vworwe3eArgugNfggU4KUjU4A8q535foUOD4WDOSJwPk6J6OFyElUvlXENexz88K/wKiz0hi46DpPcvnwfeHJIVPcMwaKjkI5x+FWrXqHTjyD8BgeXC9mT2TBjMZg3iN58fqY0x9ScVTcHtt6PS5GgHR5Aeo6R7HP5kvy56igTwB0/qmOzsZKnFE5aiP4wSWUBUCs/Ic8Zl8BTyWw/tq4QahLuIu5zKh+XJAXE9i8sPKgBpuOF9Ctfaqr8B/wDIwYPgw+ArugI9vGFWj0bmgHeTU9GOxT6NBYP9P7xX2q7mUPYoZS4x7Xoyp5cE36jQQENMGcrrhwo5rIBuf3R1r+fx6MfBVMNiOF+gO7uK4J7LIr+XIZvuUI578343XXoFc8Ly/F/zdICe0cwk7PafTkCCxEaJ455yPiMJQ4T2YYMdr8FdRWhmRhlYXLxYuVwtmXK7n+EGOPwGN6jvNh37HctVPwqhhKXt4dKoiHbw+U9OMPkKefsYX8Z+Yo5uvFPyTR0TcQTKvaquR6euQczJUD6AQNAuezeDQfl3AYfzJnzq30HPQY+D/wCYbplPaTAKb+XHJV+jQw87hP0w+LNXJ8zgFEehLg0FfLj5BPRYYyIPQzAUA9mUKVXtzPoPbqPHfvWoYwQ8B27wLQcHwLlP3cr0GN2HQe0YVyZQ0kDOCQKoVz4wRAgryD4DOhrkGVGnXGILwOCpHAfP9mXvNpE3KqJwuZfwzIc5x+BlIUOAYP27uAFBbDxfiOBwaHwT4i5xGo/UyxxI9ifeyVHP04kW12jkH0B+Ok9iREzVd/ocLsLWnBh5h9NGC/ZkJ5AD+82V7QD93EtuY5fBtA9X14NPSaeMC4Hkwtqpo+sPQ0zu1PQ1+19Jvq/ZvoMLyGjzgHu/oxPdcB0H7bpdH0GUOVlO35PgjtX8GOhbzdUkj0Nzcg9qhiMV9dGEIIHSgB9G5crkFENOgues3JAO10cH+d9GVyE9rj4CufsA8r0byt8Lz8HU/JlDDnsR+65aLxaKT7xQiAkSOsOp6OKe1xiCmMYPAnS5ZKI8Pv04P1n03jTVbsenky7zyKjiCEnJklOkzjkQ+ZR+DhgTH8ARwuLTGFmRfUX5qM7jS1AGAhERojphpdeVvPE/xHpHwnwP+O0NTEEionpFE/pHCL3DUy38ndUSxfQduKxG7OvItX+Y4dX/AOJ8BgV3TkPazecewcGnlKeCrdWAn295+xPaz/XGuB/BkMJ/13l0NW8LX56zuAVfQd5fg5xHLkk6/AYTC8mLthHsrgyFUDQO7NUrHQSMigq6sWkQyq6fZp0mN2I8jtPTkkgqFWcYIKQBAs1gWtg7kjb20Ykm7DseE01Bi57ZHxUdjNOHBp8BjA/IF8wrumafQDy5ptP2LRB3euj3eVPsxvH5seBHHuXiIxEeEfD9R1NIST8JnqupsvsKjq+JPpGmJmGPrp/qcLU3ifWRLz6MfXX9h0XKpXt//wCA+RObsY3sL5hwYPAodArksZ9KXcYgegF/q4oqfor9wymwPSi4VCvNFYZhsD2HBqBqYHhulOrDvJoIGq/BjL8rgyStIY+2mo8qcU3BCgyVHI17HJVq0DETINsm6bWqb6UxlXn5TNhIrxfQeHCkjREjcg/IOv8ANHp+KG+nM70TPbEafAy/IsuAwYfgT0HlfGFklPaHkxioRAfYAP7M4Kz+5YeIDRO1/wDRyataSd+vszoZ+uva5f2OJT98re1SZbPlSgez6jDkiREiJruykpiPRPSzt/8AqfIYMNBeZfb1mSwPRuelPljgp9gE1tMnlLieSfRi9VHthuMgfgLks5OzIWY8dD24FbbwkBq0rX+J8qpuBS+vpzp4JRzUBIUc0enNfg9D7znIHBZfVnOrhCPB5Ot3flAuERq+HJjd3pMRK7qEwI8+nOEYQs4XCQ5+RGDPiHOCkMOIYnSaZmxG6v8ARgHgp/tc/Vv6hdvbtm9CqT7RM1CsA7NOwBA8iwTAZDJmIuAjERo/YgmhEmdJ+H4O34aYZgI1EfskMiFVT7V/gfzPkMNkZe1uZSp7RMVz9RkDhL+ByRP3pkkAYOkZpc+xrXS1YPgQcyhvS8uF6ZfoMJpGmgv27hLD0cfxMY+DKoax7ZgJd9vgPeG5TmFTJWCv4M54jNbTFq1YwDFhJoCNE05mZeKRnCH+zFSNO2jcwHifgw/SBmdcvRy6Nsvwr/hlsQ9ImsXPf+Hn4mofwCfpE9iT4b9HCfVOF2NV9Ih+ndYSvw4SW39Ldn2bgmfhEnCJqQEnCOkRjMDyGXsMXT4e68cBHn6TsfCYWmQ9ldKeHsR6RMB/e6ncPSjP7kxflHL6D7pvCfhmJ9JyuKfZgS/zMnV7cK7jfWKO6wz3A/3H64lf0XFn1i5wDvth/RnueCsV+3I/IH2D9G85GOjPTP0VxCcHoypy3+J8B/B1kFkdNgqoYThOJ2ufobz9t0pPwBk7Pg8twDV3hJmj+vPmc/Xj6RzhyJayJiIHPoyHIjEQU9O7hzAePVgB9j2OKr6a8o+kyS6S/MbJ8UnwBpkmQWKPWA+Kj7b0dpi88XBfXZUgf1AUcuzYewOKPt3AEMXoHCh4Z2eEmjZB30r94QFDHUl+PHxVrbq9KT9MKb6j+6P8K7lRYflvLr7GPdj1G8DkXvad/wBOeY31p5H/AE3kL7WAQvyU3gv0hX/Xdlg6QmA4x6hmhMe4r/13ff3UG5HP2J3hqnr/ANOkIZunZ+TvW6k9uCi1/h+SfxIOx8mZ1aDdOnsyBn7a+APK54fFO8GZOkfozcgTAwRiQpi6imYPa/a4hWBzYZeFoER0sHF9odpxOElyMhhuUgeliXNQ/wCEBiaIqdFxBFGUzKGCUqcTHgIPCeTxpMfHWuYR/KEwdavFX6HP+uaMkqysFLMi1Ysme8xDeVLvASg3qUcIy1EHvqvtPDgG6Oh2ZCr1J/DsCZfZ/E+D4MfKcS73oRif/o7/AP3nHmP6V3iP+I7qZ/KgYXPvo3cuvuDT8h4ckcDeepgHCC8DPtLvKQPgPgACLfwbnoOtN18AwtChXITGX2foN0uGD0Ht9rkEYA9AcKuYmPOD7cwBuJuJKoOhTR0sTx6Rx4Ip9LzhLgA7m0O45Xp1ms4oXGbsnp06L7QVX8G7x+zyL56DNYw9CT9ILqIBdj2GRRgeR7NeMPgnzp55wHlUAyIlCvKBX/YQzlVIidcKa5xbIGfsvqjhrPFFgOx1sfLH37wSXD4UnHXhXM0CeXDKhp6Y+zvL5iJ8T+B/8T4HCehwTo7oKH4MeH9Q3rZ9MyrUfa4F6FyelHJ7A+7d5I3YFfIv/hzm/qvQB7VgGe+u9pYNEIiMRyTwYC1gTgMmICwyNfgzJigJqAHa48Cl/wC5c1/EDgPAGGRWJnlBr6NwOcIZd4wQDQerhwDpB8FHOSxBcfTgRGh+Qzoe9MP6cJw5yA52J/aZuflRQGfYcAifhHFBNBaQHSX2GQCQHqj8JRkQ9MT7TS12F9J6TDABWDEYyYqALCuUDU0mPhAWhgxc5keqYY/OGz3V98FlpKTiRKnhfF8mBMhlQ6TN1HhLpMSIdXoDLyrwLQ8I+GZuavbxP9PnKYEfTjX2DofxMfwPkGYMr6/YZh2PtMvsGA8+gAv/AFx+VX7DcpR+6xan7o6dh14JcA5DfrXAPR7dGimb6hbXgw2lpdog5+hiZ63Pwlxlh+Vc8IFydfyM2Z0LjiL2/R3mMBIs5fTloEVVquMu5Mqn9GCfqBhDXu5nfSThHjSrNHkTkUGmaPpzYuP51DGRJ3CL5HHEHXAvIXGTpOS4lfIdH9XsMRA6dSZTRVwcg5DKeAJvN/oUM4NNqHD9JlrE4K2Hqfwib9SQ35uv6GVnbUjKNf8AMmJsOehPJhHgEf7M1zwcNsyG+R2Z/PEyRhSPOJ6mc/Iupfw9mBS9IvPH4TeRt46fvNrwHns+D/7eWEeU35f7dV5XcMC/eR3L28G8z/S3eTX4Rzeh+FIZzqLzS/rFsUHqAb03Eu1AQ7EaI+kcCPegZccpVf4GHxjaWIIdO4LllGGM0DNvxISq/XxttEcpjL6O3cCLxqMYhLYIiFyoh7FDrXD8gAXMlFfg0Lcl6xdQ2No4q4wi1A4ZlLhkMUQxXEuA6KXfUrlFHToX4XOSnqi544Thyfs0pcmi0lEj9J6TU7bUeYfwOSuZID24jg6hgfYmKtCH0O6CwOv8n2D7HSsq9MKCM7fJk4ORuXyVyJ9Cjm5V7ei/WeaELUOQon+QjjxD8YiUKez+B8DwrCb2zG77vi7z9eeMbzboP0mE6F+Q3Bm7wCzcf42tA/g6/eQVlOlr/rhqZ9AYPYGJad76uW2ahzDKx8nzAHbMo4yq1bj5K5dILC+XTAQEASFyBa2+QR9JM14EilhmIt7pmWLP0oEN3a1TK5JmWFZyQPZvGKWeYxjE4ssJEAs3Fvh0kzVvLyiQI3I6wDrM33xEdx+PlT05JVzi9iTSOOcJ1ja8UoaxxEh8pAD8rhPK/dScn2X9F1XpWc3s39LpPSPI52wMk9ZukryeHdCx8j1c+nyFwTM1FOEo4BDkipE/Im5X41CaqsAtecrE9iOrtWRdb+CO/E6eA4LpYDvA+B0Oz4RVTXleg85ICgwTim965HMFfFy15B8HBgZN9u7YD8smst0QOW/XGVYr+VV0eNw7BkjkuoO1wSw3OfiOgUj6Uv633v8ARr6AD+RXdfz0eV6NM9FgOg3FVpUaAVLh4JjhB4XIVym6TdPapnU76mrPgfE6IZT4LCqNAG4QBfXJHNmloUp9DlkPiOrhu+kwpNoJETKgBNLpPrJGSHUwfifxImtd0hhYFrpekxgH5KCGYvJ2EQ+8wTVQD2rxurX7ihuA6FmLqnG3V/0jwiek3RvxHsc6qpkFzpFC7jmGPgMMPg5wjEIx9EmeMC8pM0L/AIDF1n61ePZIncAKPk//AE0+w4HkavbNxyJrtwfZMz3VHBHAuA42joftyOAMtarrhnQfow+BUQ3hJ8M/+UH/AI54FQ8nBkXG/PLDxk9EDVfgMfIzqGMsR4XQ/nUS+g6D0YsxKVMYkBh/QZ8OfeS3C32sM63tL3M/jH8BzwivKqrmrjCyOTuY5c3OcuUFESJ2OCSTBdI7F/Ime3wo/rIgrEaf1BS/A0RihkHKCjzhOvrBapaX4lzLyLm8W/G49Jx/yVPaL2OMXsifnDDYD2ns/Jhtr6h2ryBgdwiU3e2+zOT5gMMYZONMouc6xigZCOlB/TE/SYYhj3Rc2n39/wBrZ539mMlp/jsBcHp31ePdl3e/Z7bOq5c3HsHE7z/qf/3RxwdMA1NP4gbwsHwcGqql/kfINxHOSEg/M5cGHha4kJBXtuaiCcDHWueMnUAK5V4WUzwxFZ9XIs1H6c5Acam5wzDTK1w+Cy1sLpLuIj9JkOApomQWxojhW+5ml/GLwtM+Igb/AGG/BIjXKdYhvYdub8Wid3F+21zA3lDwDmdydfh5DlpV05M6cPSnPOTgHOmA5NYeRmo/pyeFTfansxj4PPPu4ZkfgMSZI5PgAOnPtzV93t3E/wDRyl/7OR2vXbleeQsUyLVzuXdjn/4DGKwAqvqZgDygw/IHozfAIaq6RR4hp8FoLFpjA8vgM/QJEKrmKl3lWFWK9wdK0ditXCnKBqYXBR11Ad9raxTEUUTsSTIlJ8BxwkvIJSpTMAOlIL9jMdWv/wDQHSad7ul7eg/t4w49KQn4WTXF+sSvnTzYNmQByRM3bqInl/H0apcQkHVGs0dqvQHbz7Q/CZfKR7SaF/G9jHS9uQet4dbMen8mB8AddC0QURl8ObpyvI4OIOft38OqeQv2YngT8mM5Dl8TO3LKmWsMnV3HTpyeckuRlC5gzBqGDdXLl+JWEXnxnHyYnBGR6UcHTu9FiulmeDPKAK8AH5c/WxEP+ZE6p5Xtwt+SY8cGQVSOYVqusJc4IeT7dzYpdVy8RFr2/Riy4bzxK5vLyC5N3K6TgT5PeY9aip6uEzqubL4A3P8AkM9xyY5kjy9/nPfg8uA+I6+zIbqmEFlJ9d5wVWuZTQf6Luv9/wDuQz7x5C87gpk5B3ve4cmFXwDTGbHTgBE3QGF6u7J3T+J6bz3vO6Yz25+H+E/F8/HTf9jFE/TXf9/9cfxPgY6KoXVlOkcMTDYeTCdhMmNm1n3ov4neCnEw+aYCEev7ya3/xAAhEQEBAQADAAMBAQEBAQAAAAABABEQITEgQVFhMHGBsf/aAAgBAgEBPxDLLOrJmNzHgDe7rh3Zu/bCSzrOQw4ODg4II4VGkj2ZEkAcHOQQWQQWWWWf4BZZZx0QdcYWT8MzjOHQ6j/In6nnPghkFhssnelnyaHULfUcHnJwRBwHBwBwEQcZZBZBwQcBwHzCyySzjLMsss5zhwjM4z4H+L9X7/mnOCYwDojz5CCIgg4CCyII4D4BwcEWn7b+7L1v6WX3f2v7233Cd7H6Qj42D48M/wAc4wIb0Qw7/wBifr4jPt0Awj2OzM/NOo+BEcEPV3yHBB8D4IHbkk7ZPx2R9EtLPV/TJX93/cJ3t/S/rf0gB7afHgRdJso4kg74QTp4PPnlllhLGy0iT/En4n2E5b0YLo6fl1r7ylnwDpsmcHBEQaRpwGn+SgasgiHAlvOpd22LtYLDlkgDgCAgtQQFkBY/LP2WWzJ3pySdG6T6gdJwvEYxPnkgvcGcZnxSaOzgn6s3jPiqnoRnH06sySzncj7Y8sssg4CxGOMyOD46BJFV4ieiSp7iGSIYggbIhwAQbBwG8YxEBZZlkhJekkp5ZPTDdfIfGH0e7M+OcYNmcZZZZ1ATeDOEsw+YgAnj7/2UTTl4zYMEvqPhr9Qpaw8MmQQx4ONkB7wOwB1KnbyAzgEQRAg6ukEZBZyHxODkOUExJHhKNXUjg0/YH8bqz4Y2PK4aTrLNZsssSPmRHQ/k9Hz8Mjo4Iggsgs4ONgH2J69levIS9wZAgg4IiCIjgEFkHIcI457fc8jYg+AWcbnKExNiXVjLaNC6h6YD47Jj8kEgKyYng+JwRPzdB8iTSDqwIiCCCOdyUPuNwutHqFvcAQF4jkiIg2CNMEEBBwHyw5DkOv8AFBO5jTp/ZTvslcXv/LMllQ09k+s/A4IbPL8QzhfgSdcHAcrjbe8Lh7AO+3SnkCuvAQIIOvgQQQd7ZBBHBwHJwFn+w5JBPIO44ywef2MMe+M+OWdQSR8iGtqX18irv1ieQjo5ODg4QSFaQh32bQpVa8Aw5ERwGwdRAgyCCDYI4LODgLLOUOGka+8Hk/5HAOJLd9TrBCAR6bMg/wAM6iYQhNLWrhH9dsj6n0QwI8j4EcHBySgdsb17OneoQQQbERwQbBkREQZBZZwcjwEFkkfDCAJj5nG2k+BNQ8gYnch+n3G6P+Hj8QHW9W3tsAv3IAnjHnJ5HB1EeWRHJDtu7BlTeAEGQcYQWQWQQQQQQQcHBwEEFkHyWOM/zbXMkqAACHlAzxOaQIB3den+AaW+Fy392yxuDqMh4cjGMEcEfAQNjDPsgO63RBBBEEEEEEERBEckEHAQQQf6Mf4scHJx6ZYt9fUhhANHp+SBviZIsI39b+vB8DzgyOCzg40DWAOOf2Vw8gwIIYQRERBBBBBBZBBhHZHAdWQdWQRBpZwFnIZZ8E4J+R7zuS+kmdxwMNucCc+7MeISJ9wOfD6LdIZiJ4HrhtZ3BwR8FA2AKyv+QBBGCDgiINgyCDgiCCUnU0LIGCDggWBIGwsss4OM4yQ4ZlnGfDJ5APiWkJDEcSQ96gJ/eVjtxb08EGnwCCIj4aBGCb1K8+oAYQQcDgINggMg4CCCyAgjgOoILMlBq5Zu2TsyV6Lf5dPkn0yHfZbphB0wZEllnOWScP8AucIKMPSOoyI+ySaWqIYcnBORHBwcrhZElZfUAYIOCLIIREQQQQQREEBZk9hbpC8BbPpSFdUB62XvcfUcTtOUq2/c505dCOv2+xxhE0eSc5zjaPx3/EjhAS0SeRksycBkngiJm3gcEcHH1sIT7kUMg4CCCCCIsggyCCCAg2CCCQNYA5LevGU6ozzuwP8AFBlmc+X1Om1+6Tb2dQObj+RidWTE4znnGdcPB8T4HCAw9rxjJIy1yxYj4ZwRwcEX9nffkAAgiIgggggyCIIIIM4CI6g62AOeydT2djDTI3PgHxzkc4QmZIdeka70fqA9Ds+4UO6fl1u4/kpIyWc42PLJynB8SGIjkKHxiAPv4ByfAjgm77nefUAEEHARBBBkHIR05BBBAFowKWdWPJHn2iAc7gDg+GFhwknO2mcAQ3sh6ZI6Oo/VjKwf/YRNHqThmWSWWSWWf5HAcCcfZforROvOS8NlrkHBEcICs7wk9fYMjgIghBsEQcBBBBBe0rb+zQX7sr3I8nMWGHAbwFkA2Zysp9ySxKrYtlicmCWB0+wjpkidx8iNLJs63jJLDh4T4JxsRweREZ3onjJu/S64JNM2weTkwG6UIK6wR2wQZBBBBEQcBEEHJBJZ1O5w9fU6bYWRBnJBznUoTFbFtR0+BLMtljs9ml6T0yQMjrvIxk0ezySCzOG6DBAk6+acHBz6n1mE9iEB98FgxHBwQN9pVqxwB4BBBwERBBjALBBEQWBCdZ0wkVssgwiOMjl3OpTJnAJYJiJDIzh2eFlsdOQw0gPT2TmPkDn3wnCQSaSWdfM4PgYDEYoeT7EbHIRznXbeIMIIIIiOAiLIBgggggiNyM9ewkDyOaQLv3NBBwRxq2oViwOHOVCRLDsoljZBdjDe7QaSgtB0kJ39y3qlHEgemSThP8TjWGOD98IKvuejFkHwAxkXCD6wa2QMNg62xvshN4IMiDgsiCCCNllf/eGRuWrkMIOoJPguXYj4MuT0mbYbAyw9SJ2/XwankfRdOJZdIFY8PqezTV5CuJjJNnUwf45HA7n15wiODgib9lIsdF44QNWX/CI7O7qwJP1aHUBBBBBBwHUGMcAqPhJtJk0gQzgJOvhk7bGfDcllEl+AQwHr7kBiL6zlp5JYeoZh/EAmHc79LRNGTqyySzjOA4ws4CGpHFPgRzjOneyrHgeRCEg/Q7gAB8EJ/YUIYIOAiILcO3qEuezY792S/s+EdXE4OQlwjcIW2ymTHJKp8Rthx2XUfglsQp7bIyDRP28USK9eRiaeSdcZ3vx3jLZi0yxz0Xt+BEoNZDn3LC06GCIJ8ZnVHw9yasGQQR1BEWgWZ0gobYRYAQwyYGGIS3lgiPWBDu3J6ZxhISktrwWfWSLHXhk4ODkc4Oy9cD7stIlP2TgD49WidSST8mw4QWyJPa8bhbESJIGmB9y4JVSDZyTRngkk8eIa7+Xq4CCCCOiwHbI6Hkq6xx6vTke5Mxlpw4Irje3veF4JOHyWVkVmYfdhZrmWvcFgssTM4SSxHkIYdYcbpM/Y6Vf9IkJ7OrrDhCzP8TpL1+BHJNKqYdQQRZpN2+owCWMCQWcMPbdN4BEShrYiB1aUGUgiUJjT9h0ODyDvbdIGXTJCD79QQD7bL18RtmcrGvRK9sBYZ5YeWZw9M+fFkqMCIibx/wAtA+jd4ffcyIwTSSwyTh+C/M53Bb0IcAZEQWEv/Mg1LP67lvQdQK6sEBBkSCrP9upQ0wicfYALGQnkmPA9QQGRpLxrkAZ6hP8A3i8rhKb/AGVd+QoAR58NOFBn+rTlJNLMc5KeA8f7HF/JND+RZfkhkmWbJ8w/w2G02EEQQQZBtnIMghjY8s0kdPonWrJDsEBBshJD4D5k6Y/d9bwbYZI2ADCEOWyPdhhxluWyh5MWVuTGLEMseSwhptvANQn1PyfeRwLtj9Iaj8lWkRz1ISOWJ8s+QfIkMWkIggIQdcHK48COND+yuH1ELWZ9QQgiIDp7OH/khLp3DI6vkgzjThN9eWvhazv2Tq1I27YQYcPkoM4JZxMZtmtnB7eASw2ex5FzuPd/b3sdAvGfyOljpv5OIkBP3Fxz8gGBwk+R5scnD0bPVdEGRBBCADkIINYIiJZNFiABgJI0PuDgSIZgY8IYPsO9z5LD8RmWh1AvABJhpwvUsgNtp1JyFnAR28eHgljzguob7k0iwc/ZsOMsyyT4HJxsXowiCCCC8cERwIjh9QwJ3fZUepF7gTr6uzINshy6STTq6dWTIYYy9cERf+SH22/CCvcGMeW9ZbkxrxN15DgvrZFZaHRDDhcIRlww4PoujL1wfeEknZ9R5fc7w/B4DrfgcYGRh1CCCCOAiIiIjjTV4J6OMmWw5pd2TxvGh0+yZ2W77OZLDO7WrYHsguiPqDCWkvUx7ZL1kAcnIJVcLQMOGL19SnhbecBBht4vvgwyR9IVdP3Yck1fmGEcktoQYkOocBHBFvURwZ3NiA5kRxYyfINZwOEGYy3p4xexjX0iORfHyQ8+yhO2OAYyZAEZkhYDhaCI7ETgmq/4lPCx+ByRBAh/JUMLGx4PIverwh9sdbLsOScS9pdrpNPueHyJ+b0Zdn+w6hhkQawZbZBHARHlg/UGRE5jsEU40ZdU4Amnttb1A7eGwsLQbRIogLagKQCSSRMRfxABICdMspnt09lmWcnwGs5D6XqD6ECkFZy8lnY2J77DCXqDeoXY+4lwlMHS/luWzT4N+3p8CwUO94Ecg64zDyasZMiOPEREgMeoL3tj9sO57XlvUA2pHaECBSwrwz7l2l0WmSxzNkdzCBk4E9cJWIDA7lnj3a/c8PkcA3J11DB/YW9wASOdSOewpo3dhGdO50YxjAHdubeIlhE+7dtkfnUIBH2TJP8AALMyPRCIjgEBAZAHZyXiOCIjkNTOo7eD62cHyXIyIQNvXUtOXzJe5Z1LTjCUkhkinzu1lwl3uPJ4y0+I1Cfck6kDogwjh38kchcDP+RG+rQ4y71bvUecL3ER7/7L0/ZhfT6nMk5Sz4CWEEI4Ell9Tbpwg+LSOyJjyOSJBVnXCFIvlu8H1+Az4SEiWJwIzVtx4c8XbLw1eE0heM4ePiO1/LVWEGEz28ANgTjqYhb0tzqX+Df0CNB32GdxLlvbb1DqOlvbCgPr20A/SSfHMeVk9f8AsIjgQJ/9/wCRMnjDseRycER9TcnkFxO7RJIcng4SSGcHbYHl2wPqQHZw7ayv7ycpswQw4W3hcz9uxyA7bR6PIxGvuAI3Zkki2smrcvr9RjpD0QSMjh4f+Re26DOUnhIOuev2XF/yDT/sGMEII4yDIjgjg4QEsSezWg2OjOMn84zjNLOGhvZC0ZF5Kp11CH2Zz6Iayc5kPwySzDkfogDU6WC+F9r2x9PCDurbrM7tJACUkkJEwp2MXQP+wBn5OFG8ixEJnH24PcyWfBk6w7u72WNj2v8AYIgiIs4OSI4CwMvqPWMCBYHLU4JmPJwECmEIQY6JaWTo9TQxIOyWYgRZIwSHSyDHhS3qDWANZ10R318gr09QAQb3sgdO4O92yQmLrZ1whMlqUsU/I9j/AGXgsvoj2WDPZIx9SEk+CWdMpfZ9rXf/AGCEHBEcEEREcaBtouMO6mv7BSxLGJgkE74YWQCWPn1DnB3B3VekH9yVOht/VmuxQYSy8qMf/YfXtgcTLpE21kf+QD9fyVhe2AIJO2EF2wASAyQTuMMMvXA5Pozxf9npPuxwEOEesdcRisng8uxtrdVFyCCIjk4IiOS0+/qBmvsuSRj02FfbrJGT+LEkdtmrIj2+2Rp1YO5HZ6yhwO49l7miHhJ3sOdSo4OW/Y20+rfxB9SE+iF8IT7ARnAIz6gDn3KBY+TuXZgQMlQVsxy3qGMvWcnw8Bx4y5r8lgzyTzDqEHUERyRwbrANPg9H47knjDFW95eL1sYEOySR3Sxv/t6PqQh1shMgA5BPT1MHb3J32YSTobKv1IrzME+T6IIH7Gu9gZ3BQAdSpbA5jYNoEk89mL8PESdXufZ8CHwPeTwPbzjqxOZMfmeCIiI5I4z3TRwX/wAL9i3JMCHYAxmSC3ggj3u71gjkAMIDWAMC2O/bC0SrlhYWy6y95YBILFgdxCcNOHIgjrtkBMVXecWyQuTp5KTAY9Z9l3eF9PDu9Rz1Nt7eOwh23YkPXD8O883jgc+OCI4Drg46au3dlnA5GG6IzaIbYPYXogBK6W47LWOEUjVxszerQkgprYlh32exBhhM753VqzKHX3ahjs7sgtc4p1D2V3IfUebKZwfAcM4PuPey6t1/6mTrk47LiRHByRycHB62XTjJ8m3OECe2ODURh1DksscujlUe7cJBLHkr4WNjG/Ta3kI2v/kIWj922v7KH3afU8mbEDL1AG3qXWWhPccPsfAa7+W67z7ZmbREk+WTz2RxIiI403qD4HBwmmSYWcPnGdcakLaCDTeAjstYct2SGcOjg4LYt6wkwjolwhqtprKPV42VEENYY8kCTrkQcDCcGQ72W1Xrzner1+JHLxPCXaxMLPgdc8WIjgkUzYJoxwcnZwRHqeEkgxyTgbeo8LOuMGJmPXAodWh+BLOzv5axbyy8DwWMuvIbBh1J1BwBhkQSDqB3WXqBe/q68jhXM5HJywZwQUJO5YeHlNV1UREchHI8eODhNOSdc4MjZM4RiS2D1a71bhHq7El1ed/JXgN2XJdZR22yFEvbDDhyEHwAgCEOghfyV/JV4ZwanC/UHewdSmcDsHwOPCPZ+v8AnD4lwXteN6cBHUmW9JdGf2IjggsjjvG7G+cBpHBxt3ODYZs4ZNkdwslgksh95MR/ceodn/ZMBe7xD1G3hEu0/ZMeSxgSAfIHLAgZVurAsBzn1lDpl1gi3C3Xvg+BwT+QZe4jrF7JOj+T0+BDMRHJEfAODs4I4zSOMhLUsW2t2/8AJTzgiRDbDBu46T0SNAf2fRJhPSIcbdhIjF45BtkYdSL+QWNfIRGnIMMkbs5lhI4GYOoOciDLfieceI4aUz0Wbj+S64zrgYYGWkRBHJHJwcERZJSlYF1gFZdZYaZYe7qdT0kx4zgO7W/yAOn3Z3bt4DTgOoh1CGu2atlgdyvbAHRYXuAJQszN8O7ZZcgn46Z/bWIPh4QbZhwvUeMNBLhN1LOSHQy0IiPgdR8DgiOdJAeBzgH2VhLu0nQz05JoMmPwAZxkGcDSzGHUCGOoA9hnb5Bus5bhaHqVWXCZiQFs8LaNfuOFC0tODgIOrILMOc1gwyeXyOj+Ss6n4bEuuBDByfI4ODhOuDMY4iZZj1I9+pAwkEyC9Q6SdAz6k+R5tnXAE2TIawY5AaZc6vYcMtGvkMNnpavZ5woMrLvCaQiAOrrgzJX4HnAgznp8Ac1+BPC9rLpt7cb1yms/YYh/YiI6Ij4DHBwcEcJpkudShxiHJeo1I/5IDSS9ZGFLB1nRJhGJZHS2iHTwpJAWflkmWATgt3t8lSrw5L3hevOO+x16wn5Dblux8ALMg1gBss4cB+Q2Ga49uFw5e5HHeJ3OrWd+8HJycHBEcHB5JHcq08hHpkM6jSU86kkdnog64Dqw02Y7MsKQhM/OM6jhLDbwi4W7PX3KDbbbL8AYPiQQYRDJ/wA3QvqtwLYLKBDyceocHWw65zgm6i03gj4lvxI5IjgNbFZA9kqe9kI9nskvTeEcg64HGfvbPGw71hpEEPsmEssIPfBvyON+IIxZnBqwhrIOiTvcvAl/loGHxCXqJrabDDDfho8DUSxz8iODkOT4HBEcEHUSaSEAiGejfeDh8s7nwkg0OCYWzeYj6lyWQeyXnXjPmcAsjhujB6+yl68kA23XeBzW0DPhuR1B1IvXw8HwzOB2yaf2OAdcHn+ZERF45JGKePSCZ9X3gs5NTAwsMk6h0wh6LWYve8ZZnGm2vxBYx7aBasQ5AsAJWYQO625LA+IHr2RtvwDWL6jkcSWgx8Dg9b+S0OCPifE3nxERx44eKKc8lODqLxEG8HpBhZBwyQSYLsToT9b3/HFhfbYHndi1iCDYIWBy3PJbV6JV1kDCVZj4ZnByuEt26iS28YHH3gOpNEnpBiODg/wIMiIiOPeeKEOPsh8AMTB1yuFuuyoZ97ep11PwxseHT7sWj6ia8HAQhMA9sB64U8PYb75YH9lNh8c5RfIZDw+w/Y5hPrnbC6+xEIMVCeSEGNyCCdjk+QcHZERE3oTwQyiSGOTVvWEg6t5C1u5cJepZY7LWFhbbW1+RwKM+sYeXb/lgGvATt8gN+5Xh8zjb1g6sw4cH9k3X3x0Pgw9cFgyQMI+Ah8yOCIiOM2ft3P5wZljDFj4NOFBn3KZ/ydRwcHJwfA4afuz9hCVeiR9s/wCkt6LH1jDzgGQOc4wDW9gzqOucgZnC6+AHg4GTHbsEcHB/gecEREWS6LpH6XnU+cfZD1wfFYWhKrvyPiRZGWkJD+EJ34XoXuA8JUA++Ue2wLt8uns5mEHCmXbwfAMI7Y53hZ2MKZ4hE0ty2SycjgeTkNOA05DCLIiJepa3+2Cn1LRwmEk3OB42Wceez+HsHU/I5PgC/UI9x9lsH3ZeEt65NZIfuB8tXr6sPWRnUqsHVoSzFnCwRx4Qtt0uzeEJEMgb6caSdw8HA8HXXkECODr4ERES4pY/+2RNUeW2y7JHOBhtlJNw9gD20hGxfdh9NnMLgD9sfbAfd0/t+Bbt2vnBFkAhAhbhA2Duxl2v84aEvXGkC2fAg64XrIALTYknRYyK08kGya2fAUY+ByORLycERE+MhftMn8SaY+SJ/PgPRDb1DMDfuFe/2I+Otttq/BOCCDqyCDCCxqAQhbYvDQTxKQK5GPbQ4WODjepayL19RkBDDjRMsx0l6nd6JUMSDuPtukHew4/DbeQ4LeAiIiFmF0fskvJ/+wg0lQ+xgc7J3J/UOOcbktZePB8T4nwIghSwMgLGRjpdEjJi2IFdQT2UDC34BhwcL1lkQcEOcK084AGWH5PUzuU6PUK8DjyEQ8HxIiLcFmKfUER+7Ar6uyXSTJUf5OMidYdxlnhstd/f9TgggIEHsEByIHcB0S0wnT3KH3bvQSO/qAO5IYWq/A4ODzhdclpxjuEAHJHAw8unB8BeDk4OCItr+yLo/YcCaAx5vD5JvciM6kst0moQYZ8D5nIQMQIBCEixayrY/bKZTeoFBO5pdSnoZ1f8glA37mRWXVpEcbCnXAbI5pajLrhjRg7153LbY5OB4HqOHcQ6ET/juHh84eFkJI6eGghLR62w+PHdqxLPiRsQcG8kJb+F29lv3Ydth4S/PJX5ZZ8C0hAjcPqwC+/G5Da8MaafkKZ6hLbOocYeoYR6ZDKHLfgLbbawpDE4ORSH2LwlpAVnqPH2It+mTJNJ0lt6s6k4TU5FLUhluP2R+Fq+rX9ccVkwID7L2A8MP3ALAtJFqVTvIWXnOf4OrDyAE+paS4WnryAI40TG34mCGHgzxJE7HqXXkMgkmNpCWw2vA2kMMNpHl9xoL9sEk8ZGEa9QoZwUZJOEs64PgHxEhLYYSGGFtbuxhfdhCFpbrhZYsSgWtjA2fPb169hhbhLvUMLBMYYWhbwurYI4R0cHxJ9IMjJT/I1DDbCMNsMgFfqa2LsgEfZB6sgcS0sXVu+2pwkjJygvDM+WWZEEERn5CflpDa2vGMKIAS4Wh/l0aQrFgT225KZbyy4SLPqAHC9cjJYS6Qw4TL1kiLnkG1buw6YGHgbbeMEbJ0hlwjcJEWk+g+5wB9saBPLBYAnExkJpKnsInwzPhlklkBxlkFlkQhAWIBdWlpK2rBfZrIWHX2AcTrhBPHtsx5O2rCjA5XCEIgodhgjm2IMc4TY0z7mnaUA+yLYlIUIY4OBpOjKPTABEPG9XYWIshDgMOnDwhkverXxtH7kf8Q+ZxjYwNjBZAQBZYyCYvUB2EkJ1bznAWhtgdMhmnA5eFsMC2oQXDyF97Y5HLY4jwHSeyOmGGHhtssgkhIE4G2YtH/bH6Mhqf1FwvkeSEjepD3y08bp8keB5yyNMcAIEAsNsLHGhGthwZabJszF22bBbaWK4QQZdh8SXDZdYb7oCQIMIerTg4IuW48A7MQ7C7c9QiaQ7ygn9tBjw8LL1O6z6g6LIw2Y7IPcb8yGUJ69Snzq0elp9Qrx4xsbUhTkB+wP2x+2P2xw23jZVq2Nj9tg+5QsFqsI4Efd1YH7CfI2zhdZCCEOF1bE/t0OdWfj4gM+I7jdHq64SEgOZZqPUIkcDjwvUsyg4y6WibZS0/iH6+ry2OSBiOCEOoRtCUNGS7O5Z2WnpiR/ZZ+yE+uYx9QLHBixLX23bt/Vu1bEw31DPXIL1uvhbzDq1Xg+REsJ0jyNgE9ZagHb5ZPYsIcCPgOWgRhWnEcljaE+s/Itt4TqfOG3vIHT9jpBqYd6gzj043IR4ODgiFIWF+zZB2S8p42wwbUhY/Ul9R+d/OPztvqF+o+yFkE+77DB2HsNk50ZLTBlX1tf8zg4NjI8hPtjUdWXbK228nJEmmR08LC3SOlthOTZeFg6W7B/JMH64MOXZjzknViQsOxDnBEQ2vI5bbaNsI22hY5EJaJZZ4TqA+Sh6wMPJ1wHDAw9gIULTuIlHA7s+7wPHd2d5JHHqQmkERBoksqMKOCOyNepjQjfI25K5wINbrpYJDnVvUmcItfIR7PeDhLEtSESE48cERyf5vk+Wy8MIuEfHNkjXdhjjYiXCwsIwM2MhrA3X2emTgkuBGF64PWk/XeI4OAXiOXifj4LOy98Px9R5yOXjxHBHJ/ofF8b0/wAD4EXnkcF7vR/y+sQPH//EACcRAQEBAAMAAwEBAAIDAQEBAQEAERAhMSBBUWFxMIFAobGRwdHw/9oACAEDAQE/EODyGUQbshT4eMH/AGXRlSHR4/sCFaIMB+WIQ9/mRzsttshQXCMo6t2ctttl5NttttJixf8AbfkAx8AYgRCbDD/Ich4DDDDDDDDwjtSAoecChDbyWwxfT/l4cjM2TjYYYbSEN/ZFYJbrMC2w53MzfXr/AC8fy3OG2y9W24cNYU8ZaY8BtJm29WlocjwPSHP+AAK0x+mGF2EhIerEzgQYYYYYtLkuOcAwpbDDwZweRwRL2/yWkOPzGGGPOU7Dkk02nLctt4fOVy2emRkstpaWlspaWlpbwzJkyc9gFh+r+Bv5X5DfyY+4b+Vo9IIWf2D+wH7gSzgMMOxiIQg7Db9yXVf2GMRB22G2GF5Ofp/ySQ3lSapvp0hNhM5EQ8kOP4PLv7anBSUtLbZbbbbZerZiZJ1t36NYD1PSMIQ7QXV2CILwgHhb+bDoC/kX8i1+pUYlp9T4CU8ZWdM+B2+yX1EFYLHAtIg4wxiL2YbTmwPLYYiGGG2HkdX+XlvHHiQ8DP8AIRAZwrxJYjE/9/3gYYbYYeodYe1tuFtunCstsvC4W29W2yziY9LpdnD28IkdvI7z2w+ALIdErLXDf7xqS8m2vIpwGeRSnpHQSfudz9Tk+N0t6xB542juwwwwOBSLSVXWGGGGGHgbrg4PP8XmCDhjwKnxPGaKvS3IYYXAqzYKxICrJ92bFj9svrZ/ZGWnDWz0tttLZjFb73K9HU3r6vBJB0Ts9ngXnbbxDvD5EcEcnBGR5Cj0wjr2CmJG4GMTr/6luC8Rxg610sgPYcOABZYMGBAhtIZQzerRtISADIyELNiXhcZxEjmrYODhL0fJr1gFHDFhIZAMiwz2Y2Aezq2XHq22XScWpgXfdCN17bBhOp+Zbbb0s+W8BZZhwcEQRJCUlHBt3oxlnmkL09Mo6hFsIwbEBGFpBhuWJ3u8AGAsICQxgZAQFiW04Y9pLAIbbbS0hwkLlRtttttllttnpwoG2ANmW+Y/AnBKl5I5x4eCfkWRMDdodyY5HwIiCBg2MMaMKYmkYvRv6pD0JjA+mBYSGXVtk6SzjLCUNsdkuEuoYbZfg9xaHUQnAHB5dHPxWYi3qbTO3Ihw1u8eD9RQExZm/PbT5nJ5wQ45sMMl4DjOCCIEGchBGehp+QanTPYjn7bnCoQhhyGJRAQTt6iHPEjIYbZ5WG7Zo8EfDZa+Ftlt6ltIZZjvtrGMe/5OBmEVua/sx4F+ey2/MII43giLLEFiQQQgzgOAGyAGCyBHpgpiJAqsfyVMfPuNxHbRNhhhhyGG2GVeG2428qBracfd05OjHkacbbbLtb1bLLL1bCHRgM8WrhkPZ/LQYGEzZ+W2y222wxwHGQcPGQQQQdcECF1Oj4sdYDYIgYiDfgAsskrTqxjj+2pokG6d25IYYYYYYbYN8tFgleMCjZI6ZA6dbyjqA6+y/FLS9IcVpb1LLLbJ+0aXr9RADudS29fHfgcyPgRweR7bbDbsRBERwZCnjbeCDYMggs4zqGvA39xB63/1b5+QdRiWGJoxj0siBzI00ZQwwww2222z7t2jf/7BOHcTY0PqMIensJ3ezknhbepe1suErLL1KX0fyRaurQ4OidOyy/J57z4nBwchAcEREcEQdQwQZBEQQWFmHEtbZ8epEr98ZwkfbMJ3+z67p+wg774B2GGHhAeyQ1Ze9DfwgSV3j0fSMwMJdWDWBPZRLyx6yyjLLkiA7s1e2SGEqVt/4HhfifAjjcgcBh4OCICCCyCCINgg2yDqGPBBjogR4OcySodG1R0/JHp9hy6JQ7wFAO18IUHssAADL/Dk0JXOEfq2u5berbbcW2WUX8VAv2dO8Fl+b8v5kjHx20tLS2OByGHuHghYRyIORF44DYAz7PUkPjgPjomJ0xY+pKODGBJQw4QNX+Q4WE6LcnVlnWWZDliyJOnk8OhbLwuEgf7ItfIDD2VWfJ+O4W27x4fIA+NkTGdf+41+DktpaWww2wwxBlDEdQxwoeBEcBjHG27/AMAoJvTCUuy1UGMthh1CMH9szbLbzuc657LwpKZLLpLjkRKxeQByW35LLbrsEnO228O5avGyyAt+AsOwntGQ57/6jDtspCfqbJOpKjidwVyxkOQww2w29cBwlKIiD4eI5PikczqYH3KaMm8IHKelhZykJcCRFLzhZZepbYj2+sEAcPCW3T4rLyf8C2yJSHesW88wfu8FbC/V9ItHhAGBJXyx+W/iRdZP5Rnk30y7NafktwZB+wRBG23qXUOnASUvIdIjg8js4P8AhFP8ZQFsKHSI6fIdifib78mnfTMrLKBL1KRi1XggEDwlvvw3rleThed4VOD0mb0DWVHoQ4obIcCU9EvdXhra/snODv7v+4c+4ZRkDoz0UnNOn9liDoSLg6tGEsZLqUMjdkgfOSI58RyfAHgwwsPZG9H2U7GTI+QYrl/fherbepZWWWUtgEaB19wrwJZdbSU422Xg+bsuS9S9WDgT++Fm4d2B5jOpXOEEEcHHiICAsM/t2OPkCdewDDqB7WMz3aJ1LCUpShhhiI4OiOD5EUiyQe9j9RAynx9iQ8bf7w+cLLLLKcLCxD7IyHspLW3C34v/AAaQ/QSRudTMCIPiBAYEgpN7d+W2xyICyCyAkJKQfHyNS23sfZCZKUMoZQw9Qw8DEcHxTFV1gvvOw+k7+hlyfJXj7hhfGcOGfJ4SgfcA17OmfOH4PyY4fJbCd8liJ5AYfsC06PYweBLnLX4+PgQQLEJkHwHINLA4zjv9kBLXohRxkZDwKRCEFNy1bGZyRwSA35PkB9Q//Zz+PkrZYyc2zjMyXN74WVmMI/fn+ygvZQ6OX4a8Z83nXd3uAgYHJdVixCGZVfhmxyEEOoAhDqHZYRPeAszlJIZCOfZd7jAPIHwx/Zj+S4FsoVJ2IuSBGG2oFLU9jyOyONt5nwzIvafROw7+pXzM2EsvCBs+74QCBLOZwvUtttvB58Nl5eiW2dwSPT3A9LqwvXeR15DkIchBtjzhqQ3ScE44j9iCD9l9RBIAwlOwyx9SyWMoXPZSYTuUVY7IiODg4Tj2lgSd0+olQ7LvpL1Ez5PCzWH7gDLZ4SfidnyeVljAefsaUgLL6JcMtt+W2222wwQvERPG6oY7RpaFH2RC8aE619lqr7gnS3SbJYekgmMS5O3LccfZIeBQy4HgjgG9331JUxzg9rxarJ0HyN/J4VGXq2WXLMWVMIDLZSc32b0OpTqwCh1LMj8l4W2WXScge2SZ5GGPq2Ze2WbYd4DSLwn4EQIIINpF2WMyF0ZJ+/vBtgZYjj5IZ5JexxiUTV1sI9nnGSHP7ImMguiUupQ8DDEQwwiXj2vJzgeSMsMvUtpGDbIefcAB+S8PRMhGn5H76/JQ4dE+D2QqJj+zq/VunG27wtpLPS3S0BPuzBHb5Bs+slJ5tuu8PB7wWgTqzgWWQhCTz5N6NiPZ1LS4yT7uTb9Dad+nuPdkvGPv1C+Ppwlx7Py247H6/khkkf5DAQ6+5KU+5Ww94wQShCBCQkC04DbyPtLRFsMv9ln3I/sqWCasAP3GL9Z0z5xiofsRP8nS/ADDDYjjS0nhcOFUwIE519waDwh3Dw6ny8cPnIRwi9EZLCIEjUCAQRCnjBObC+7qxZK7KaOxhekpdZbJiP7aTzYD9LO55wBQ/cY6ZKmSkpaXk4EeQQQEAQEkY1GVgZfqFMOlLOrLJWXSTALuTqRBGFB4ewBHgJbeF0P7IAPj3V7cu5xvVssagD23QNfqQEhouqyKSI8PnwDCcsbPS04B2NyBiONy2VywdbKvRhKetr9sftBO7a4CUT3gYUd/Lp/xY3IR+F7LMLcT0kDEnAohS2VpkMKQ0zerT6IlxyZldby+DJpMv0YAdLuEs0Pvz/ZmXrKJZi6P5Y/xbzLqvC4S2yktivRaYX+EAiezSP7L0/2Ik+4dJMLJSHWzqBYDkBnAjk5R9toeHASGQ+li+u5Z2MmCJoQ8fHJJzR8i0JMH+NtMkXwnZDqwDkOiHYjg84POPf8Ay8+DheVSAPPuAIPCW2XkRVadQlpJ7SP6JMU4XqW3CEwPZ8MFMCCe5ID0yoaWBjAMmN9QJMEh7JMw4OTYdRwRKSQlXbg4CxsVgC7HftjBM28bY4IdZYJBf1f7REVP5ZKcMyU48k8BFDHkQxDDevxnhciBmdcuEvw0hDpCfYA/bU6lPZcLd4XrhAIR1O4ztgpFrwHGAf8AbRYCzHOEEjRgE2xsnXJwGvJEuEq1eCIgYgZEbY2Lj8s6ZgT7ikXZnghjh3/y0RYLM6SOcCIJShiEyHj2+IszaQD2Ig+iXT4LnwFDhs8vkrjliD9YBsiuTvwWMIkkZKLMOQj0+QyZ8k+BDpHCoS6+27EEEEIdeS+CZ96snsB+4NzYK0j8ZifrJjoj7vM8Db386mDX6gSe7AAfdoybQmA/Uj7Jc62DNTqWMuo4HD1Lwj4MuXbfks8PkfA4I1SUkfZJJ7JSD/uxA8LoSyTOXgZmTuHOpNNl8CfBCcDkumSMySDIhwH8TvR1E68IjHv9n3uFq/cDdk3RiD/3ZoY1p/I49e2qu2BfxKiW7iAP7C74+pNRAQYPd3GmZKKQ62FGIeC7L/OIMPguE8GTX97mbyyfA4Q4MqqvDNqmOBaXAkjUlESzLNJI6YcZAyVifLC7wJJJIjg0nZ8nYGGkEGSkA2LDoIZD/uS1dleAhMWl4W2/URASYP8AjEOEusTzbcT8S7q6/wCBJpaCfTdw/Ja+2PN6kyhhh4en+Xk+DLIl+vYAB4TyzzlkfBs6mPbDAJK5gO3fdfx+zKJjMzJiO2eYsDZNQI9ecDonzh2wgWAJeNsZBrwIH+wAmiGv5bYvX0WMaSM0giDCRfw9jPoHsL3X15Fh9vBcPgektSef4EthKFPJTR4DjKVvAvT/AC8/BtZHXhICfkcHOaWST5P3LFP7EC0q7JBWXbOge/siH0ngEdMSVHqAmPsEGW7xgtgWA6ymSrJPkNeBMCcC2GdW5XX9gutuB8Z2Sxx+5xHUJcjwj8t+2ZTLPA9ZQ/gf+50tuHAXkHUu4Q6P9l0P5eSGqNvsxqo7+pTehLZcNc4D1Pq8R8HhFr+uz3/hDXJM5UQZWj7JJYd3Bl2mrJgBFEViSMXoff7KvoXVBk4Pc8Zw6PcvMMgzvhIFqSi2kiECtgWQDC4E3Ame/EYA9XcpAHluRr/JMdfGAZxIju/nUeJ6yODMJD+/yd62ksMHBcI71gaf7aE/DLxx4KRaSAP+/wDJSlHAvS8R8OwfqGX8S8nPj4g4F7LJV7XZOAV6GXBTiR0EGoRDyTCGf2DbMTxmSDEnMujCHIMyCMAwEE+5Zj7KjCbWLLB4XYB3ZL2YHBndkM7iB1DS6GyVioiUjySYndjoWv3qXwLv7ZYe92zd6h6DIcNhncsLo/5DrbwTwksDDSwGfskRfTkpRw8T6uhHnwYj6O5AYfkuvy2WuTsO7t684OHyyzqTJi6awFxMhcxeADv+x8gYsyskg4ztEziUPGsKSgk1kywyCBbUimmZZrmH7Hnr9sYp3NWvkwWgW6Q6YHcdD/b0ZYBcuhk15CwTyW/ks07J1i6QppbrsMNtw39jkroIschg/wAhmJBDv+SBlKGU9ricpjbNlr88hnI5eHgQkaL7HB0/7SvE0UBI4zr0/H8txzT9/bLZRYwMIMv2eBpEVnQDWE1/6mc8IPT7NTIiJZFrBgy6Ze2DP12w7n3IK54fbKdniFJVeix+yxPXkUYkfT7/AMm6Pm2GXeoO84DP/ojh5P8AY1aewJSehxJT48TlYMcX6l34MpH2xmJ3mxKkmPnwHDypAs9J1DCdlmXueU+I6NkLD/2gLDRsSuP5K4MllgnhAC3CM2Qml2m+iR8gNeWLDr+28hA+7qy7mTp/bJLyvBngwTP7EIfosyOqkpmLUjOnQ2r0wWRn7wkf4yGPj7L0hI4RbgvAu3/S8T+wxLe/kGOWYYbdOI6cvpIYf8+SAX6YAH0so2Sm1BZhxnXC4MoROIOB9Q3jbPLTpsULMtRhQy3ZBIzGOlzT8m2+MoNHZRTDA7CGQYEoYOSnVrEnmTti32R1k9mEvWdUmF/Xczr7Z0cA9ZOSiy2ra7DLTDliQ2DRkBcM4eOD6yIeyBR0/wCx8hhP0Y7r9jgWucDy1Lc+hLjHes/DojbA3ySk5IYznxRgMC6gyudeSiuecBID9iVYvkGGsg2R6hjoSx4ZbE8RBS1nCCduSR0gZm8kFiwECBtokbs7fJR8gATNTRf/APs/saP/AH/1v4+sodRr4WA1ZQ/sGYMsxv1bbBDTeGECkJ6bMYe8jol3gZrMDOx/28x3Nvf3u8kshiEOkBc2FPI7B/bwH8jz4kk8YwlXg9s+Oz5adHRawGB1DbZY/klxAY9kRr5EgLARSTm24OoN0ivfrJlhKGJ3I715BC1ssgeQht0Z9x4awEezZHUA6PcqJtvoPv3e++yiBe0g+EiKW/y6ZAIN4CCyRCzJg8GdieM3aTsl64DXIcclofghohgF5f7CiCjko5ActAWMfUREtx8T4Bgj3OU4CTAF4Tn8nBYc8urAuQmdx9lI8en9kQnZAduQA9nxztlcWFjx2/tjosjepX86tHvdh7W6WhYyBDkacYDO2BO+CKfeQ86ZeezvjPverW7OH+2B7CHZKeoB6ygwlNyBiAGRCTwDO/yMt+oZBuvy3e+Bnd2f8l3WOhngIYCwH1/ZW/kojgCc48L2/wCAgLrOANsySZiEAgtWQzhNYfkIiYyQgTxyRdcbb6SjgZIva7w36QMIGWm7nfEadELsAvcB9R+BAwp1sIH4wr/SUWBliUCH8J27sX20yD7lJviOyDWDIGTy3JhYw1yTHIYv9vUNbZkGz0QYX7ejaB+SwS0/0RoPSNH4A4y7f9b28MdvJwCzOGUiuR85EVnM+BhGbA9yHdbaVdFiz+y5B02BhdQnOjaFyxHuA+PcgYyE8LH1YYeoDJTOvYKFPZKCeLAfvBMbUqVDdjHtJIZaYFMhhBsddbAwLUMlDMMZNhwn/cSZ9WCb28t14DWHf+E7P94v/eIZf1ttfjh6IiOP/nenIcEQRgctvoxLPCz2SALquMkWU0nDwrnVoYYY3cJtrqGEI69lE5mwdd++5nX/APP9vQ9e4RklNSEPJV8IIa2LZMaRq4RhxIUeTTFD7e/8lTRPII+ZA/YQy0NXJAdMgPdlfSxfYIMm/wAQPB3IjJGDZRJWTgcL7n8tW/Vus/kMdl28OMgl3fpbrFxFNJYbef2JYkMOEOvH/wA728nJHLOodoOrLONSQrybsMJ49N9L1YlgyYXbO9BhGDYD7AHYtSRPPqVW6IHzWN+EKdYCCRQRnh7I/wBSd9yXnvc535M8e2yg9yTdJY6NswlYNsMw9lPuSfZrLTAw4FpbQxtMSGQdQ1h1fLED59/5Fu2RJbOdVZcPz3EdYaH4E0D/AG6x+APhenByciOBDhOGin/dhd+mfcj2NEYf7KP5KP4yzs7svSR+oA1YQSABpZfh8ZUkqan7gBKwSUM+3yx/19g/w9jbIODJObY5iwpo2O64sI99k+wxt+9k/TH3QA92SYMlPXkRgZv5abvB0S7yOWGU0fqeA8I1PzyMgB9yB/h/7mZfcPeQz/uGofhez/f/APbM/wAJEUlLJRw8EtXJEcAnsR8zPuwH8l7YQw23WArfZXzY8jGPfd5PCRdrAOsCweQ5C5av0gHElFB0gzs2MQRv3LojfwsXej+//wAhkP8ARlDX/Zwq9+oCWSYAsIIB6dZE1EPOTtjJ4wYAeQWGGHsmSpfcx223d6B6236iSdH3Z1+lvPOBAZe/V1Z9vvAlv9E9x+Gf/YdlsX8l2/Za8hw8LDv/AKiODhAim2MDGIOD15zqSJY5DHiPXBoe5d05HG0Sy5B0QHZ0QRRQEDd+h3Hgncs7DLV9HcpaMIxeoFDIfXcmF9V5eGKPyWgki5NHue8Azw+Esa3bubCDzCHD8f2UD1AHsoZOmvQesb+ZDh6b6+xx0WHfgldrY8hqEN19ESsnpf7Hqfy3HUHAI4eg/wBvJ/nIRBZkItLQJdsOkWciyZ6P1YO48ty1YQO5A69lQIdRf+ie5/L2waZYP/J9JAGwAP5IVIh09nSstbelnq/6S9b+S0ZZpKCfyXXf2ARBWXalWRYQnS68HUit7H0bBOXRbDolPrJJV51YtXcpwYWjGfXdjD7Z2X3x1kNd8QJh5HBLBY7uWl/YdD+3j/kpNqbwUEx4fsdsbJH8ZdL/AC7GIixGxtQJYyLP9ltfcnCh59QNjasZ2PR7OCHGESB9OASTZiJYiWzvxsEfsPiWOfkna2ylluOOk4Gei3C7Usrjd/6mvPufXDZn+oL8hG+ZIwyq6p63rKTAlRx+BwTD7QLC1fYWiQH43WvIhg+3yddHnBwEcCWZ9ywhohmf5DT/ALdT+NhoR5HB1S0/zgiIjgbR6gk+bwF4WcpQn1GcZHpdjYmTmHb/AHq6YSDq0lLMjMkrfy31LdXbVk4yw/shxgJITCE7fU6YI6SkDEzILWrwC+EnZ6P29AP7KdWEOiXbyEy1WODkKL/8lmF0IQ17IdPUecZnsrMLOSHBg7fCZqkxhupAmOj/AEj2P3bSP7wM4KjLX+GTHOQfAggwhB1HOWdST5vjbnlp9sN+LsVLAHcyRktCZ9+Tp29kwIIAtJeIZ65JpLdne/yGpdMuomyt2vwitpfDhKrq7yasbYxsEGG/Vr6L6XqQOHVn9ZHj5HYdyOrgtCVz4HDsCIfT7n6HhdpYCsXn9kxf+7Rf7ZI8DhDG7sPcQQ6+AQYRsEHVnAcZ1JGkJ+yTNjjMOM0mZ76jtPpKNGwn8WiJDf8AMao/ZcYd4O2EHZDRP0jmfyIQFjg9fIHf6d/9ynqz7gsgggAgg1wJjA6gNRZ9UnzGsxwwlXYbmz0Dot518hfgckV9v0SnX08BQH3AGH0TzP1lh+CG6/W1DBHCQxf2OMEQ8hBxkewaQYcBlklkyMhP9sxyyCTvYSSSYn68Y9x4y7kmD+WBP2GGflm4+7LJSA3QYDAAmWDYd62zh/IQmxKrwIaZ9WDgT/4mqxwegvtrHi1kGvRL9mp9XQHRa8nwOA5L18lf45IKvrz/AGN2fuDZ+r0P7dIdh5HGXh+LcGI15DWzOM4CDqOAsySySTJK6jeksP3CSBGzTJyS1IFfqMIHEu5BuX/RR0Q7kcYaSyWHNWsD2IK+u5o37TvcsAEGuEB1wI7OrAcCBXo2R0YEAej3APaA7XYHmbJGHLrld77vB8zgLA6g/fL8fVvALDR4HsAIeHVqH2YWwfZHRdQQ6gLBkwaJIX+IRQ+kP4IjjM4IMeDgOMk65ST+yujEM8YU7tP9ceMjCleNsxEpn3I2omINP7bD/ZDEhAniYK/k6MgBr6nRfIcJ7ZBBrkP6v0SZvZ/JQauEN7dYB0SJgShV22/8u2smx9iNINdfJd6+reDhhV6+Sg//AFER+9y6n9jgl0LMYIWSEmjPQ4A5wREEEFkOtkHwTqSSTqSy1Hqwa9jIeGwH8nPYdA/O46WiWmIPGcxCb9zdVlMZhh5D7C+nspnAYO7kAa5AEf8AufQ8YPDkZ/ybBtgJDAHcg6r2yzwRL3eHsK4eEmn19wKeR1DW7Ge4g5ekkmBlpB1HRyPIILIN64CIOuUskk6k6sw4Rj9RgLPiGR3dixZYEjJugSuLomajLBBhnA1i2rItO2d7jnP+VYV/yDCCCUC1feTgKJTF6+yIZ+wEXqW1+oR1f5PRggsk7ZNuplpHnBEGwQQQRxnGaWTJhyhJM+ShJAv3JwvV6n0YcC8Mlg+S6TgI6S6QJ94DXLYV4TJB6IdP/CyDIjC7fAiCQf7O+yh+EJ1+omUg637ijiDeCSdSaR0y65DWCCOAyDgIOM4TlJOpLIdP9lIIZx4jnCw6Qw48N3g44jkCwPXoesK+sn/hP+IIJTwsWwPgQdwhbArHsjV9ZVVfuF2j9Q4C0gZAWS008kyekMnpwGsQ1ggSLLIIM+CSWSSSYTMOj/ZaWjMkJejdC+9mNzhkRyaFnmyvPqIPpPY+R8Q+DwHABCHlq+3R8SIbNbAIw+o7kpR6JLYEG0u/+x7YdQcKzDyZBkZDcGkEGQZYE2a8M4DeCOA4eE4SZJugf7LgS0IOBiY5OSzQISaeR/dTq6+/+CFmcnJwQwcBYRdPBEh5loI1kdPCDM3ZsNUaYHBwySSSSwn5BBBDgjgj45JZJhMzbD+SCf2aOMY8mXpHwJSAqFBWEbC/7tX17+ZwfHOD/hy0gWwLEFgH9tk9B6/y8RZ6wcbMkb/ktX9bRZNVjgyaWTGPSeVmBLIIIiOCPjknCYSaSYS9MXFjEQ9Lfs9lpn3BwLawfBkhD7hAvR7O8Og8/wCc53fgcHG8AWh5dsBaSPXoPWLPIPuDga2j+B6xs8CREuYsIFisX0sl69kWTiYI/tvx+uQIIIITMJhAWWZZxknUl4mNHFfvYBNkLp7adPBxlnBBXA7lZ9/RKl9/+D1n/EB8AAh1wg7uY4OoAOBrIs+o/LT1kto3XqDS1Zhha2JJGMyfbGTrgM0ewCQWWcCQqbZJ5ZxkFhJYEknUnBJYIop/XkYnAMLLxZBBAsP1JO8PCH4HZ8MbVmRwf8WscDwEAFsJdXqwc7P7Kva8hrM++Qj7bVZlnGLrhA5GxwatiEczGYkmIC7YnRMGOWWWWQcMLCzOcszhOE6k6nplhYab+wxkn15DpwchGF3j4W59B/4Z/wAJEbGhAP8AZVjc5DWAdZeZNWDeERXQQp0NqmylzgmBwYAdJA2C+s/mUujDThmQbwyyCyyyyTqyTqTCTrhisugfuBRD6hx0h0sTzyETgjhl69ut97fJVK+v/NvJ58tLSPjsscHBwXYmZZf7ts4S6wmUErbJoR4VDLJxsHv2zg1BwDJBNIHjOc4zqSSTqTqcMf8AZdD+Rx/qT7PZRIP1AP6Qbn7IijBIZ9HdnDwjO8/8NbV5CDktt3g4ODg6OC0ZEz9impdWDZW7HOJZJ1AtmcZMdJgcMWWcMkLJLLJJJOrxJraAG3jvk/kR8B07ZnT2QMKVqf3/AMJ2xsQZZZyfA5OCI4JFwgH+zaPIMHjknAcVcEiOS59ReJ1ITZ0YCIiG/wBtWrHkxYWWWWWSLJnINY5qGFp7PSHe44IieP8APuAxPG6dfuN4Pgf8ByFgRn78Nt+AfE5OAkWAP9hV1b2aq+2eGMDODEHsafRMzCSGSbasQnSTw9THE7kElkjZY2NjY2MouDykOoL0Wu+hDjp5CMOoI4OH0r7l1n4kfLOM/tj+2f2A+2w/Y/2z+8HGp4Wrzi2EQcnxOSILCBhOYWk9W6eDuwLJPO7ZtvBr0gOvuJpIGxJDsZHo7sHN4Wro5GJZyk+SGSdRwk1yRCA6P1ILp9Wx3DIiODgWO9/vB/wZyfLDjTg4I+YRwRBjC5hIFm19mXfV1Flt7rLhdrRYhwlhXUN2MJFgS1GXTgiFn9jX9nMTGYlklkkkMODjqTqcOntgTp0kefUeaeRkcnwOD/gP+LS0tOAR2xlnnkGcBBzvBIzLfEch2/kiv2gQjrKZha2a8Awk4TtniSCGS+F1sHr3fQZfosk+CyF0SWMnBYIB3JJJODwY0rDswaQBIS+j5LHBH/AfA+e28alrA2NhYR0WSZHo9TAHpOcjyITMt2r0Tg9B+yoq1Y9lJ+oA494yDkW+eWcYf93+JMJkhscWrFQ4erFZJLCyCHDQO2EeUniun+T3W9JLdLNszhqFIT4HB8Tk+JxhwO/Dd5S9iDFtU1d5DgIUMZds5EEs1ctANPuZFYGwzuykPjG6ez0F6tYQOQ7siZNnC6GmzGYssgN0SaWDuLThvL3bD15PRvH+Q68EsbCwjyTLEhEIm7f6fA4OD4b8NOCyzjbOAEAcmkfABaHcu1WHOCZYTrF/lIAhHskGDOLUMk1sORyREJpLhqAF9QgPbuULpYjYxJH+Wmk5kcPlnLVh9w0jixq08gEgxjgjhORTxgbiQidNo+v+DLM+GQcmX/Xy0hCGxsYD7bQtfPiLeM7F1lk2L0HqAMs4EQdUs9fCIQsTpyc90iWCQ9j1IJmJ49wZep9TiyAjo5VCZgW4P7JuwY7+w0kjkjeBLPml2R4GUcGl6hkl43c6bXntifXJ8DnLI4LGEw5B62h4bY/LX94OD4BPAO/dq2j+W4wIghGP1+iV0zc45nBwGEQ4kZxs1kkGGDZoM8GQSYTPk2OJbqwGTSTmTuH6kgxh4TqFXJ+WEhsj0YV6chfex4JsB9Mj8o+7qPqb+uX4MOPb6dv7397H+yXhZ+iW+Ft9ZCutsX1ggCOD4EEagHuQl4T+vLGQn23qEkbYJHb7hU1+IEmEcbBh02TXk+ieDEJ4XCeE8eguhkkcZC8eRy0s0szjOUssLJOcLOTFhBZAgWIAgICIjyA+Bw7wdxWsAEAQm4QYM6JYJnqAms6SiSLCIENXUPUIMPgA9DA6dyOCSyDLQMQYkh09kBwJQYCR2LLOpJHJMIEIZ9zrYKNmEOlkPCaRo85jbaNkjYkfDLOA+OcEEHVkEWREQO+dSw15OCBL++wp9EKkGwCC9Fmawj7JNH20ZPbAgRh1bJTJEbRyQavfADODvF/ZiC/D1LsvRMBHSSsieH7uqnV/s+cTg/8AERPk/I8+ZEcHJwcPD/b/AOX/APXgjgjmR9RWdN4k34CfJuce5OvH/9k=" />`
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestShutdownKeepsHits checks that a hit still being served when shutdown
+// starts ends up in both the day file and the event log.
+func TestShutdownKeepsHits(t *testing.T) {
+	dir := t.TempDir()
+	const ua = "Googlebot/2.1 (+http://www.google.com/bot.html)"
+
+	events, err := OpenEventStore(filepath.Join(dir, "events"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats := newMemoryStats()
+
+	flusher := &statsFlusher{
+		dir: filepath.Join(dir, "logs"),
+		// only the final flush on shutdown writes.
+		interval: time.Hour,
+		recorder: stats,
+		events:   events,
+		metrics:  newMetrics(),
+	}
+	flushCtx, stopFlush := context.WithCancel(context.Background())
+	flushDone := make(chan struct{})
+	go func() {
+		defer close(flushDone)
+		flusher.run(flushCtx)
+	}()
+
+	entered := make(chan struct{})
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(entered)
+			// still in flight when shutdown starts.
+			time.Sleep(100 * time.Millisecond)
+			stats.Record(r.UserAgent())
+			if err := events.Append(Event{Time: time.Now(), Path: r.URL.Path, UserAgent: r.UserAgent(), Crawler: true}); err != nil {
+				t.Error(err)
+			}
+		}),
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Serve(ln)
+
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "http://"+ln.Addr().String()+"/page", nil)
+		req.Header.Set("User-Agent", ua)
+		if resp, err := http.DefaultClient.Do(req); err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	<-entered
+	shutdown(server, 5*time.Second, stopFlush, flushDone, events)
+
+	counts, err := readStatsFile(statsFilePath(flusher.dir, time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts[ua] != 1 {
+		t.Fatalf("day file counts = %v, want one hit for %q", counts, ua)
+	}
+
+	logged, err := ReadEventStore(filepath.Join(dir, "events"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	if err := logged.Scan(func(ev Event) error {
+		paths = append(paths, ev.Path)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 1 || paths[0] != "/page" {
+		t.Fatalf("event log holds %v, want the one hit on /page", paths)
+	}
+
+	if err := events.Append(Event{}); err == nil {
+		t.Fatal("event log still open after shutdown")
+	}
+}
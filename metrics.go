@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// metrics holds the counters exposed on /metrics.
+type metrics struct {
+	pagesServed  atomic.Int64
+	bytesServed  atomic.Int64
+	activeConns  atomic.Int64
+	flushErrors  atomic.Int64
+	tarpitActive atomic.Int64
+	behaviorBots atomic.Int64
+
+	endlessActive  atomic.Int64
+	endlessStreams atomic.Int64
+
+	mu            sync.Mutex
+	requests      map[string]int64
+	verifications map[string]int64
+	robots        map[string]int64
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		requests:      map[string]int64{},
+		verifications: map[string]int64{},
+		robots:        map[string]int64{},
+	}
+}
+
+// recordRequest counts a request against its crawler family.
+func (m *metrics) recordRequest(family string) {
+	m.mu.Lock()
+	m.requests[family]++
+	m.mu.Unlock()
+}
+
+// recordVerification counts a DNS verification result.
+func (m *metrics) recordVerification(result string) {
+	m.mu.Lock()
+	m.verifications[result]++
+	m.mu.Unlock()
+}
+
+// recordRobotsViolation counts a hit that broke the robots.txt policy.
+func (m *metrics) recordRobotsViolation(violation string) {
+	m.mu.Lock()
+	m.robots[violation]++
+	m.mu.Unlock()
+}
+
+// recordPage counts a generated page of n bytes.
+func (m *metrics) recordPage(n int) {
+	m.pagesServed.Add(1)
+	m.bytesServed.Add(int64(n))
+}
+
+// connState is used as http.Server.ConnState to track open connections.
+func (m *metrics) connState(_ net.Conn, state http.ConnState) {
+	switch state {
+	case http.StateNew:
+		m.activeConns.Add(1)
+	case http.StateHijacked, http.StateClosed:
+		m.activeConns.Add(-1)
+	}
+}
+
+func (m *metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = m.write(w)
+}
+
+// write renders the metrics in the Prometheus text exposition format.
+func (m *metrics) write(w io.Writer) error {
+	var b strings.Builder
+
+	m.mu.Lock()
+	writeLabelled(&b, "gridlock_requests_total", "Requests received by crawler family.", "family", m.requests)
+	writeLabelled(&b, "gridlock_verifications_total", "DNS verifications of claimed crawlers by result.", "result", m.verifications)
+	writeLabelled(&b, "gridlock_robots_violations_total", "Hits breaking the robots.txt policy by rule.", "rule", m.robots)
+	m.mu.Unlock()
+
+	writeMetric(&b, "gridlock_pages_served_total", "counter", "Generated pages served.", m.pagesServed.Load())
+	writeMetric(&b, "gridlock_bytes_served_total", "counter", "Bytes of generated content served.", m.bytesServed.Load())
+	writeMetric(&b, "gridlock_active_connections", "gauge", "Currently open client connections.", m.activeConns.Load())
+	writeMetric(&b, "gridlock_flush_errors_total", "counter", "Failed stats file flushes.", m.flushErrors.Load())
+	writeMetric(&b, "gridlock_behavior_bot_requests_total", "counter", "Requests from clients not claiming to be crawlers that behave like one.", m.behaviorBots.Load())
+	writeMetric(&b, "gridlock_tarpit_active", "gauge", "Responses currently being drip fed.", m.tarpitActive.Load())
+	writeMetric(&b, "gridlock_endless_active", "gauge", "Endless pages currently streaming.", m.endlessActive.Load())
+	writeMetric(&b, "gridlock_endless_streams_total", "counter", "Endless pages finished.", m.endlessStreams.Load())
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeLabelled(b *strings.Builder, name, help, label string, values map[string]int64) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(b, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(b, "# TYPE %s counter\n", name)
+	for _, k := range keys {
+		fmt.Fprintf(b, "%s{%s=\"%s\"} %d\n", name, label, escapeLabel(k), values[k])
+	}
+}
+
+func writeMetric(b *strings.Builder, name, kind, help string, value int64) {
+	fmt.Fprintf(b, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(b, "# TYPE %s %s\n", name, kind)
+	fmt.Fprintf(b, "%s %d\n", name, value)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(v string) string {
+	return labelEscaper.Replace(v)
+}
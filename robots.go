@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RobotsConfig controls the generated robots.txt.
+type RobotsConfig struct {
+	// Default is the policy of hosts without one of their own.
+	Default RobotsPolicy `yaml:"default"`
+	// Hosts maps a host, or a "*.example.com" pattern matching its
+	// subdomains, to the policy served on it. The host is compared without
+	// its port.
+	Hosts map[string]RobotsPolicy `yaml:"hosts"`
+}
+
+// RobotsPolicy is what robots.txt asks of every crawler on a host.
+type RobotsPolicy struct {
+	// DisallowAll asks crawlers to stay away from the whole host.
+	DisallowAll bool `yaml:"disallow_all"`
+	// Disallow lists path prefixes crawlers should not fetch.
+	Disallow []string `yaml:"disallow"`
+	// CrawlDelay is the time crawlers should leave between requests.
+	CrawlDelay time.Duration `yaml:"crawl_delay"`
+	// Sitemaps are announced in robots.txt. Paths are made absolute on the
+	// requested host.
+	Sitemaps []string `yaml:"sitemaps"`
+}
+
+// Robots policy violations recorded on events.
+const (
+	robotsDisallowed = "disallowed"
+	robotsCrawlDelay = "crawl_delay"
+
+	robotsIdle = 30 * time.Minute
+	// robotsClients bounds how many clients' last fetch is remembered for
+	// the crawl delay.
+	robotsClients = 100000
+)
+
+// validate returns the problems with the policy, prefixed with name.
+func (p RobotsPolicy) validate(name string) []error {
+	var errs []error
+	for _, prefix := range p.Disallow {
+		if !strings.HasPrefix(prefix, "/") {
+			errs = append(errs, fmt.Errorf("%s.disallow: %q must start with /", name, prefix))
+		}
+	}
+	if p.CrawlDelay < 0 {
+		errs = append(errs, fmt.Errorf("%s.crawl_delay: must not be negative", name))
+	}
+	for _, sitemap := range p.Sitemaps {
+		if !strings.HasPrefix(sitemap, "/") && !strings.HasPrefix(sitemap, "http://") && !strings.HasPrefix(sitemap, "https://") {
+			errs = append(errs, fmt.Errorf("%s.sitemaps: %q must be a path or an http(s) URL", name, sitemap))
+		}
+	}
+	return errs
+}
+
+// disallows reports whether the policy asks crawlers not to fetch path.
+func (p RobotsPolicy) disallows(path string) bool {
+	if p.DisallowAll {
+		return true
+	}
+	for _, prefix := range p.Disallow {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// robots serves the robots.txt of every host and checks hits against it.
+type robots struct {
+	cfg RobotsConfig
+	// sitemap announces the generated sitemap on hosts whose policy lists
+	// no sitemaps.
+	sitemap bool
+
+	mu        sync.Mutex
+	last      map[string]time.Time
+	lastSweep time.Time
+}
+
+func newRobots(cfg RobotsConfig, sitemap bool) *robots {
+	hosts := make(map[string]RobotsPolicy, len(cfg.Hosts))
+	for host, p := range cfg.Hosts {
+		hosts[strings.ToLower(host)] = p
+	}
+	cfg.Hosts = hosts
+
+	return &robots{cfg: cfg, sitemap: sitemap, last: map[string]time.Time{}}
+}
+
+// policy is the policy in force for host: an exact match first, then the
+// longest matching "*." pattern, then the default.
+func (rb *robots) policy(host string) RobotsPolicy {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+
+	if p, ok := rb.cfg.Hosts[host]; ok {
+		return p
+	}
+
+	best := -1
+	policy := rb.cfg.Default
+	for pattern, p := range rb.cfg.Hosts {
+		suffix, ok := strings.CutPrefix(pattern, "*")
+		if ok && strings.HasSuffix(host, suffix) && len(suffix) > best {
+			best = len(suffix)
+			policy = p
+		}
+	}
+	return policy
+}
+
+// check returns the violation of the policy of host by the client
+// identified by key fetching path, or an empty string.
+func (rb *robots) check(key, host, path string, now time.Time) string {
+	p := rb.policy(host)
+	if p.disallows(path) {
+		return robotsDisallowed
+	}
+	if p.CrawlDelay <= 0 {
+		return ""
+	}
+
+	key += "\x00" + strings.ToLower(host)
+
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	if now.Sub(rb.lastSweep) > robotsIdle {
+		for k, t := range rb.last {
+			if now.Sub(t) > robotsIdle {
+				delete(rb.last, k)
+			}
+		}
+		rb.lastSweep = now
+	}
+
+	last, seen := rb.last[key]
+	if !seen && len(rb.last) >= robotsClients {
+		evictOldest(rb.last, len(rb.last)/4, func(t time.Time) time.Time { return t })
+	}
+	rb.last[key] = now
+	if seen && now.Sub(last) < p.CrawlDelay {
+		return robotsCrawlDelay
+	}
+	return ""
+}
+
+// text renders the robots.txt of host.
+func (rb *robots) text(host string) string {
+	p := rb.policy(host)
+
+	var b strings.Builder
+	b.WriteString("User-agent: *\n")
+	switch {
+	case p.DisallowAll:
+		b.WriteString("Disallow: /\n")
+	case len(p.Disallow) == 0:
+		b.WriteString("Disallow:\n")
+	default:
+		for _, prefix := range p.Disallow {
+			fmt.Fprintf(&b, "Disallow: %s\n", prefix)
+		}
+	}
+	if p.CrawlDelay > 0 {
+		fmt.Fprintf(&b, "Crawl-delay: %g\n", p.CrawlDelay.Seconds())
+	}
+
+	sitemaps := p.Sitemaps
+	if len(sitemaps) == 0 && rb.sitemap {
+		sitemaps = []string{sitemapPath}
+	}
+
+	if len(sitemaps) > 0 {
+		b.WriteString("\n")
+	}
+	for _, sitemap := range sitemaps {
+		if strings.HasPrefix(sitemap, "/") {
+			sitemap = "http://" + host + sitemap
+		}
+		fmt.Fprintf(&b, "Sitemap: %s\n", sitemap)
+	}
+
+	return b.String()
+}
+
+// serveRobots serves the robots.txt of the requested host. Fetching it is
+// recorded so crawlers that read the rules can be told from those that do
+// not.
+func (t *trap) serveRobots(w http.ResponseWriter, r *http.Request) {
+	t.recordHit(r, kindRobots)
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte(t.robots.text(r.Host)))
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRobotsCheck(t *testing.T) {
+	rb := newRobots(RobotsConfig{
+		Default: RobotsPolicy{Disallow: []string{"/private/"}},
+		Hosts: map[string]RobotsPolicy{
+			"closed.test":   {DisallowAll: true},
+			"*.slow.test":   {CrawlDelay: 10 * time.Second},
+			"*.x.slow.test": {},
+		},
+	}, false)
+
+	now := time.Now()
+	tests := []struct {
+		host, path string
+		at         time.Time
+		want       string
+	}{
+		{"open.test", "/p/a.html", now, ""},
+		{"open.test", "/private/a.html", now, robotsDisallowed},
+		{"CLOSED.test:8070", "/", now, robotsDisallowed},
+		{"a.slow.test", "/", now, ""},
+		{"a.slow.test", "/b", now.Add(time.Second), robotsCrawlDelay},
+		{"a.slow.test", "/c", now.Add(20 * time.Second), ""},
+		// the longest pattern wins.
+		{"a.x.slow.test", "/", now, ""},
+		{"a.x.slow.test", "/b", now.Add(time.Second), ""},
+	}
+
+	for _, tt := range tests {
+		if got := rb.check("client", tt.host, tt.path, tt.at); got != tt.want {
+			t.Errorf("check(%s%s) = %q, want %q", tt.host, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestRobotsClientLimit(t *testing.T) {
+	rb := newRobots(RobotsConfig{Default: RobotsPolicy{CrawlDelay: time.Second}}, false)
+
+	now := time.Now()
+	for i := 0; i < robotsClients+1000; i++ {
+		rb.check(fmt.Sprintf("client-%d", i), "a.test", "/", now.Add(time.Duration(i)*time.Millisecond))
+	}
+
+	if len(rb.last) > robotsClients {
+		t.Fatalf("remembering %d clients, cap is %d", len(rb.last), robotsClients)
+	}
+	if _, ok := rb.last["client-0\x00a.test"]; ok {
+		t.Fatal("oldest client was not evicted")
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/rand"
+	"strings"
+)
+
+// pageSeed derives the seed for everything generated on a page from the
+// secret, host and path, so a URL renders the same page on every visit and
+// across restarts while pages of different URLs are unrelated.
+func pageSeed(secret, host, path string) int64 {
+	h := sha256.New()
+	h.Write([]byte(secret))
+	h.Write([]byte{0})
+	h.Write([]byte(strings.ToLower(host)))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+
+	return int64(binary.BigEndian.Uint64(h.Sum(nil)))
+}
+
+// pageRand returns the generator for a page. Callers must draw from it in a
+// fixed order for the page to stay stable.
+func pageRand(secret, host, path string) *rand.Rand {
+	return rand.New(rand.NewSource(pageSeed(secret, host, path)))
+}
+
+// canaryRand returns the generator for the canary of page in session. It is
+// keyed with the secret so canaries cannot be worked out from what a client
+// sees.
+func canaryRand(secret, session, page string) *rand.Rand {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(session))
+	mac.Write([]byte{0})
+	mac.Write([]byte(page))
+
+	return rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(mac.Sum(nil)))))
+}
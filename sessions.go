@@ -0,0 +1,192 @@
+package main
+
+import (
+	"math/rand"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// sessionLinkLimit bounds how many emitted links one session remembers.
+	sessionLinkLimit = 10000
+	// sessionLimit bounds how many sessions are tracked at once and
+	// sessionEntryLimit the pages and links they remember between them.
+	sessionLimit      = 20000
+	sessionEntryLimit = 2000000
+)
+
+// session is one client's visit, from its first hit until it has been idle
+// for the configured time.
+type session struct {
+	id   string
+	last time.Time
+	// visited holds the pages the client fetched.
+	visited map[string]bool
+	// emittedBy maps every link on a visited page to the most recent page
+	// it was seen on.
+	emittedBy map[string]string
+}
+
+// sessionTracker follows clients, identified by IP and user agent, through
+// the generated link graph. Because the trap emits every link it knows
+// which page a hit was reached from even when no Referer is sent.
+type sessionTracker struct {
+	idle time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]*session
+	// entries is the number of pages and links held by all sessions.
+	entries   int
+	lastSweep time.Time
+}
+
+func newSessionTracker(idle time.Duration) *sessionTracker {
+	return &sessionTracker{
+		idle:     idle,
+		sessions: map[string]*session{},
+	}
+}
+
+// observe records a visit to page by the client identified by key and
+// returns its session id and the page that linked to it, if known. The
+// Referer wins when it is a page of the session, otherwise the most recent
+// page that emitted a link to page is the parent.
+func (s *sessionTracker) observe(key, page, referer string, now time.Time) (string, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) > s.idle {
+		s.sweep(now)
+	}
+
+	sess, ok := s.sessions[key]
+	if !ok || now.Sub(sess.last) > s.idle {
+		if ok {
+			s.remove(key)
+		}
+		s.makeRoom(now)
+		sess = &session{
+			id:        strconv.FormatUint(rand.Uint64(), 36),
+			visited:   map[string]bool{},
+			emittedBy: map[string]string{},
+		}
+		s.sessions[key] = sess
+	}
+	sess.last = now
+
+	var parent string
+	if ref := linkPage(referer, ""); ref != "" && ref != page && sess.visited[ref] {
+		parent = ref
+	} else if from, ok := sess.emittedBy[page]; ok && from != page {
+		parent = from
+	}
+
+	if !sess.visited[page] && len(sess.visited) < sessionLinkLimit {
+		sess.visited[page] = true
+		s.entries++
+	}
+
+	return sess.id, parent
+}
+
+// emit records the links served on page to the client identified by key.
+func (s *sessionTracker) emit(key, page string, links []link) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.sessions[key]
+	if !ok {
+		return
+	}
+
+	host, _, _ := strings.Cut(page, "/")
+	for _, l := range links {
+		target := linkPage(l.URL, host)
+		if target == "" {
+			continue
+		}
+		if _, ok := sess.emittedBy[target]; !ok {
+			if len(sess.emittedBy) >= sessionLinkLimit {
+				continue
+			}
+			s.entries++
+		}
+		sess.emittedBy[target] = page
+	}
+
+	if s.entries > sessionEntryLimit {
+		s.makeRoom(sess.last)
+	}
+}
+
+// sweep forgets idle sessions. The caller holds s.mu.
+func (s *sessionTracker) sweep(now time.Time) {
+	for key, sess := range s.sessions {
+		if now.Sub(sess.last) > s.idle {
+			s.remove(key)
+		}
+	}
+	s.lastSweep = now
+}
+
+// makeRoom sweeps and, when the sessions still hit either limit, forgets
+// the least recently seen ones until a quarter of both is free again. The
+// caller holds s.mu.
+func (s *sessionTracker) makeRoom(now time.Time) {
+	if len(s.sessions) < sessionLimit && s.entries < sessionEntryLimit {
+		return
+	}
+
+	s.sweep(now)
+	if len(s.sessions) < sessionLimit && s.entries < sessionEntryLimit {
+		return
+	}
+
+	for _, key := range oldestKeys(s.sessions, func(sess *session) time.Time { return sess.last }) {
+		if len(s.sessions) <= sessionLimit*3/4 && s.entries <= sessionEntryLimit*3/4 {
+			break
+		}
+		s.remove(key)
+	}
+}
+
+// remove forgets the session of key. The caller holds s.mu.
+func (s *sessionTracker) remove(key string) {
+	if sess, ok := s.sessions[key]; ok {
+		s.entries -= len(sess.visited) + len(sess.emittedBy)
+		delete(s.sessions, key)
+	}
+}
+
+// requestPage is the page identity of a request: its host and path.
+func requestPage(host, path string) string {
+	return strings.ToLower(host) + path
+}
+
+// linkPage is the page identity a link points to. Relative links resolve
+// against host; an empty host drops them.
+func linkPage(link, host string) string {
+	if link == "" {
+		return ""
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	if u.Host == "" {
+		if host == "" {
+			return ""
+		}
+		u.Host = host
+	}
+
+	path := u.Path
+	if path == "" {
+		path = "/"
+	}
+	return requestPage(u.Host, path)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestSessionTrackerParent(t *testing.T) {
+	s := newSessionTracker(30 * time.Minute)
+	now := time.Now()
+
+	id, parent := s.observe("c", "a.test/", "", now)
+	if parent != "" {
+		t.Fatalf("first page has parent %q", parent)
+	}
+	s.emit("c", "a.test/", []link{{URL: "/p/one.html"}, {URL: "http://b.test/"}})
+
+	for _, tt := range []struct{ page, referer, want string }{
+		{"a.test/p/one.html", "", "a.test/"},
+		{"b.test/", "", "a.test/"},
+		// a Referer on a page of the session wins.
+		{"b.test/", "http://a.test/p/one.html", "a.test/p/one.html"},
+		{"c.test/", "", ""},
+	} {
+		got, parent := s.observe("c", tt.page, tt.referer, now)
+		if got != id {
+			t.Fatalf("session changed from %s to %s", id, got)
+		}
+		if parent != tt.want {
+			t.Errorf("parent of %s = %q, want %q", tt.page, parent, tt.want)
+		}
+	}
+
+	if got, _ := s.observe("c", "a.test/", "", now.Add(time.Hour)); got == id {
+		t.Fatal("session survived being idle")
+	}
+}
+
+func TestSessionTrackerLimits(t *testing.T) {
+	s := newSessionTracker(time.Hour)
+	now := time.Now()
+
+	for i := 0; i < sessionLimit+100; i++ {
+		s.observe(fmt.Sprintf("client-%d", i), "a.test/", "", now.Add(time.Duration(i)*time.Millisecond))
+	}
+	if len(s.sessions) > sessionLimit {
+		t.Fatalf("tracking %d sessions, cap is %d", len(s.sessions), sessionLimit)
+	}
+	if _, ok := s.sessions["client-0"]; ok {
+		t.Fatal("oldest session was not evicted")
+	}
+
+	links := make([]link, sessionLinkLimit)
+	for i := range links {
+		links[i] = link{URL: fmt.Sprintf("/p/%d.html", i)}
+	}
+	for i := 0; i < sessionEntryLimit/sessionLinkLimit+10; i++ {
+		key := fmt.Sprintf("deep-%d", i)
+		s.observe(key, "a.test/", "", now.Add(time.Hour/2+time.Duration(i)*time.Millisecond))
+		s.emit(key, "a.test/", links)
+
+		if s.entries > sessionEntryLimit {
+			t.Fatalf("holding %d entries, cap is %d", s.entries, sessionEntryLimit)
+		}
+	}
+
+	entries := 0
+	for _, sess := range s.sessions {
+		entries += len(sess.visited) + len(sess.emittedBy)
+	}
+	if entries != s.entries {
+		t.Fatalf("counted %d entries, tracker thinks %d", entries, s.entries)
+	}
+}
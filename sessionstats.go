@@ -0,0 +1,290 @@
+package main
+
+import (
+	"encoding/json"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Crawl strategies a session can be classified as.
+const (
+	strategyBFS     = "bfs"
+	strategyDFS     = "dfs"
+	strategyMixed   = "mixed"
+	strategyUnknown = "unknown"
+
+	// strategyMinHops is how many classified hops a session needs before
+	// its strategy is guessed.
+	strategyMinHops = 3
+)
+
+type sessionHop struct {
+	page   string
+	parent string
+	time   time.Time
+}
+
+// sessionSummary is the shape of one client's walk through the link graph.
+type sessionSummary struct {
+	ID        string    `json:"id"`
+	ClientIP  string    `json:"client_ip"`
+	UserAgent string    `json:"user_agent"`
+	Family    string    `json:"family"`
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	Pages     int       `json:"pages"`
+	// MaxDepth is the longest chain of followed links from the page the
+	// session entered on.
+	MaxDepth int `json:"max_depth"`
+	// Branching is the average number of links followed from a page that
+	// had any of its links followed.
+	Branching float64 `json:"branching"`
+	// MeanHop is the average time between two pages.
+	MeanHop time.Duration `json:"mean_hop"`
+	// Strategy is bfs when the client works through the links of one page
+	// before going deeper, dfs when it follows the newest page's links
+	// first, mixed or unknown for too short a session.
+	Strategy string `json:"strategy"`
+
+	hops []sessionHop
+}
+
+// buildSessionReport reconstructs the sessions in the range from the event
+// log, longest first. A non empty family limits it to that crawler family.
+func buildSessionReport(events *EventStore, rng statsRange, family string) ([]*sessionSummary, error) {
+	byID := map[string]*sessionSummary{}
+	var sessions []*sessionSummary
+
+	err := events.ScanSince(rng.From, func(ev Event) error {
+		if ev.Session == "" || !rng.Contains(ev.Time) {
+			return nil
+		}
+
+		s, ok := byID[ev.Session]
+		if !ok {
+			fam := crawlerFamily(ev.UserAgent)
+			if family != "" && fam.Name != family {
+				return nil
+			}
+			s = &sessionSummary{
+				ID:        ev.Session,
+				ClientIP:  eventClientIP(ev),
+				UserAgent: ev.UserAgent,
+				Family:    fam.Name,
+			}
+			byID[ev.Session] = s
+			sessions = append(sessions, s)
+		}
+
+		s.hops = append(s.hops, sessionHop{
+			page:   requestPage(ev.Host, ev.Path),
+			parent: ev.Parent,
+			time:   ev.Time,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range sessions {
+		s.summarise()
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].Pages > sessions[j].Pages
+	})
+
+	return sessions, nil
+}
+
+// summarise works out the span, depth, branching, hop time and strategy
+// from the session's hops. Hops are put in time order first: an endless
+// page is logged when its stream ends, after the pages followed from it,
+// but carries the time it started.
+func (s *sessionSummary) summarise() {
+	slices.SortStableFunc(s.hops, func(a, b sessionHop) int {
+		return a.time.Compare(b.time)
+	})
+
+	s.Pages = len(s.hops)
+	if s.Pages == 0 {
+		return
+	}
+	s.Start = s.hops[0].time
+	s.End = s.hops[s.Pages-1].time
+	if s.Pages > 1 {
+		s.MeanHop = s.End.Sub(s.Start) / time.Duration(s.Pages-1)
+	}
+
+	depth := map[string]int{}
+	children := map[string]map[string]bool{}
+	var dfs, bfs int
+
+	for i, hop := range s.hops {
+		d := 0
+		if pd, ok := depth[hop.parent]; ok && hop.parent != "" {
+			d = pd + 1
+			if children[hop.parent] == nil {
+				children[hop.parent] = map[string]bool{}
+			}
+			children[hop.parent][hop.page] = true
+		}
+		if _, ok := depth[hop.page]; !ok {
+			depth[hop.page] = d
+		}
+		s.MaxDepth = max(s.MaxDepth, d)
+
+		if i == 0 || hop.parent == "" {
+			continue
+		}
+
+		// following a link of the page just fetched, or backing up to an
+		// earlier level, is depth first; moving on to another link of the
+		// same parent, or across the same level, is breadth first.
+		prev := s.hops[i-1]
+		switch {
+		case hop.parent == prev.page:
+			dfs++
+		case hop.parent == prev.parent:
+			bfs++
+		case d < depth[prev.page]:
+			dfs++
+		default:
+			bfs++
+		}
+	}
+
+	if len(children) > 0 {
+		followed := 0
+		for _, c := range children {
+			followed += len(c)
+		}
+		s.Branching = float64(followed) / float64(len(children))
+	}
+
+	switch total := dfs + bfs; {
+	case total < strategyMinHops:
+		s.Strategy = strategyUnknown
+	case dfs*3 >= total*2:
+		s.Strategy = strategyDFS
+	case bfs*3 >= total*2:
+		s.Strategy = strategyBFS
+	default:
+		s.Strategy = strategyMixed
+	}
+}
+
+// sessionsJSONMaxLimit is the most sessions the limit parameter of
+// /stats/sessions.json can ask for.
+const sessionsJSONMaxLimit = 5000
+
+// sessionsJSONHandler lists the sessions in the range, as many as the limit
+// parameter asks for and sessionsViewLimit by default. The number of
+// sessions before the limit is in the X-Total-Count header.
+func sessionsJSONHandler(reports *statsReports) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rng, err := parseStatsRange(r, time.Now())
+		if err != nil {
+			http.Error(w, "Invalid date range.", http.StatusBadRequest)
+			return
+		}
+
+		limit := sessionsViewLimit
+		if v := r.URL.Query().Get("limit"); v != "" {
+			limit, err = strconv.Atoi(v)
+			if err != nil || limit < 1 || limit > sessionsJSONMaxLimit {
+				http.Error(w, "Invalid limit.", http.StatusBadRequest)
+				return
+			}
+		}
+
+		sessions, err := reports.sessions(rng, r.URL.Query().Get("family"))
+		if err != nil {
+			slog.Error("sessionsJSONHandler: failed to read events", "error", err)
+			http.Error(w, "Could not read sessions.", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("X-Total-Count", strconv.Itoa(len(sessions)))
+		if len(sessions) > limit {
+			sessions = sessions[:limit]
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(sessions)
+	}
+}
+
+// sessionsViewLimit is how many sessions the HTML view lists.
+const sessionsViewLimit = 200
+
+func sessionsHandler(reports *statsReports) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rng, err := parseStatsRange(r, time.Now())
+		if err != nil {
+			http.Error(w, "Invalid date range.", http.StatusBadRequest)
+			return
+		}
+
+		family := r.URL.Query().Get("family")
+		sessions, err := reports.sessions(rng, family)
+		if err != nil {
+			slog.Error("sessionsHandler: failed to read events", "error", err)
+			http.Error(w, "Could not read sessions.", http.StatusInternalServerError)
+			return
+		}
+
+		strategies := map[string]int{}
+		for _, s := range sessions {
+			strategies[s.Strategy]++
+		}
+
+		total := len(sessions)
+		if len(sessions) > sessionsViewLimit {
+			sessions = sessions[:sessionsViewLimit]
+		}
+
+		sessionsTemplate.Execute(w, struct {
+			From       string
+			To         string
+			Family     string
+			Total      int
+			Strategies []countRow
+			Sessions   []*sessionSummary
+		}{
+			From:       rng.From.Format(dateLayout),
+			To:         rng.To.Format(dateLayout),
+			Family:     family,
+			Total:      total,
+			Strategies: topCounts(strategies, 0),
+			Sessions:   sessions,
+		})
+	}
+}
+
+var sessionsTemplate = template.Must(template.New("sessions").Parse(`
+<html>
+<head><title>Sessions</title></head>
+<body>
+<h1>Sessions</h1>
+<form>
+    <input type="date" name="from" value="{{.From}}"> to <input type="date" name="to" value="{{.To}}">
+    <input type="text" name="family" value="{{.Family}}" placeholder="family">
+    <button>Show</button>
+</form>
+<p>{{.Total}} sessions{{range .Strategies}}, {{.Count}} {{.Key}}{{end}} | <a href="/stats/sessions.json?from={{.From}}&amp;to={{.To}}&amp;family={{.Family}}">json</a></p>
+<table>
+<tr><th>Start</th><th>Client</th><th>Family</th><th>Pages</th><th>Max depth</th><th>Branching</th><th>Mean hop</th><th>Strategy</th><th>User agent</th></tr>
+{{- range .Sessions}}
+    <tr><td>{{.Start.Format "2006-01-02 15:04:05"}}</td><td>{{.ClientIP}}</td><td>{{.Family}}</td><td>{{.Pages}}</td><td>{{.MaxDepth}}</td><td>{{printf "%.1f" .Branching}}</td><td>{{.MeanHop.Round 1000000}}</td><td>{{.Strategy}}</td><td>{{.UserAgent}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
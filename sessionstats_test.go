@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestBuildSessionReportEndlessOrder(t *testing.T) {
+	events, err := OpenEventStore(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer events.Close()
+
+	start := time.Now().Add(-time.Hour)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+	const ua = "Googlebot/2.1 (+http://www.google.com/bot.html)"
+
+	// the endless page is appended when its stream ends, after the pages
+	// followed from it, but carries the time it started.
+	log := []Event{
+		{Time: at(0), Host: "a.test", Path: "/"},
+		{Time: at(2), Host: "a.test", Path: "/p/one.html", Parent: "a.test/stream/x.html"},
+		{Time: at(3), Host: "a.test", Path: "/p/two.html", Parent: "a.test/p/one.html"},
+		{Time: at(1), Host: "a.test", Path: "/stream/x.html", Parent: "a.test/", Kind: kindEndless},
+	}
+	for _, ev := range log {
+		ev.Session = "s1"
+		ev.UserAgent = ua
+		ev.Crawler = true
+		if err := events.Append(ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	today := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.Local)
+	sessions, err := buildSessionReport(events, statsRange{From: today.AddDate(0, 0, -1), To: today.AddDate(0, 0, 1)}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("got %d sessions, want 1", len(sessions))
+	}
+
+	s := sessions[0]
+	if !s.Start.Equal(at(0)) || !s.End.Equal(at(3)) {
+		t.Errorf("span %s to %s, want %s to %s", s.Start, s.End, at(0), at(3))
+	}
+	if s.Pages != 4 {
+		t.Errorf("Pages = %d, want 4", s.Pages)
+	}
+	if s.MaxDepth != 3 {
+		t.Errorf("MaxDepth = %d, want 3", s.MaxDepth)
+	}
+	if s.MeanHop != time.Minute {
+		t.Errorf("MeanHop = %s, want 1m", s.MeanHop)
+	}
+	if s.Branching != 1 {
+		t.Errorf("Branching = %g, want 1", s.Branching)
+	}
+}
+
+func TestSummariseStrategy(t *testing.T) {
+	hops := func(pairs ...string) []sessionHop {
+		var out []sessionHop
+		now := time.Now()
+		for i := 0; i < len(pairs); i += 2 {
+			out = append(out, sessionHop{page: pairs[i], parent: pairs[i+1], time: now.Add(time.Duration(i) * time.Second)})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name string
+		hops []sessionHop
+		want string
+	}{
+		{
+			name: "breadth first",
+			hops: hops("/", "", "/a", "/", "/b", "/", "/c", "/", "/d", "/", "/a1", "/a", "/a2", "/a"),
+			want: strategyBFS,
+		},
+		{
+			name: "depth first",
+			hops: hops("/", "", "/a", "/", "/a1", "/a", "/a11", "/a1", "/a111", "/a11", "/b", "/"),
+			want: strategyDFS,
+		},
+		{
+			name: "too short",
+			hops: hops("/", "", "/a", "/"),
+			want: strategyUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &sessionSummary{hops: tt.hops}
+			s.summarise()
+			if s.Strategy != tt.want {
+				t.Fatalf("Strategy = %s, want %s", s.Strategy, tt.want)
+			}
+		})
+	}
+}
+
+func TestSessionsJSONLimit(t *testing.T) {
+	events, err := OpenEventStore(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer events.Close()
+
+	now := time.Now()
+	const ua = "Googlebot/2.1 (+http://www.google.com/bot.html)"
+	for i := 0; i < sessionsViewLimit+50; i++ {
+		ev := Event{Time: now, Host: "a.test", Path: "/", Session: fmt.Sprintf("s%d", i), UserAgent: ua, Crawler: true}
+		if err := events.Append(ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	handler := sessionsJSONHandler(newStatsReports(events))
+	tests := []struct {
+		query  string
+		status int
+		count  int
+	}{
+		{"", http.StatusOK, sessionsViewLimit},
+		{"limit=10", http.StatusOK, 10},
+		{"limit=1000", http.StatusOK, sessionsViewLimit + 50},
+		{"limit=0", http.StatusBadRequest, 0},
+		{"limit=100000", http.StatusBadRequest, 0},
+		{"limit=many", http.StatusBadRequest, 0},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest("GET", "/stats/sessions.json?"+tt.query, nil))
+		if w.Code != tt.status {
+			t.Errorf("?%s: status %d, want %d", tt.query, w.Code, tt.status)
+			continue
+		}
+		if tt.status != http.StatusOK {
+			continue
+		}
+
+		var sessions []*sessionSummary
+		if err := json.NewDecoder(w.Body).Decode(&sessions); err != nil {
+			t.Fatal(err)
+		}
+		if len(sessions) != tt.count {
+			t.Errorf("?%s: %d sessions, want %d", tt.query, len(sessions), tt.count)
+		}
+		if got := w.Header().Get("X-Total-Count"); got != strconv.Itoa(sessionsViewLimit+50) {
+			t.Errorf("?%s: X-Total-Count %s, want %d", tt.query, got, sessionsViewLimit+50)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SitemapConfig controls the generated sitemaps.
+type SitemapConfig struct {
+	// Enabled serves /sitemap.xml and the sitemaps under /sitemaps/.
+	Enabled bool `yaml:"enabled"`
+	// URLs is the number of generated URLs in one sitemap.
+	URLs int `yaml:"urls"`
+	// PerIndex is the number of sitemaps listed in one sitemap index.
+	PerIndex int `yaml:"per_index"`
+}
+
+const (
+	sitemapPath   = "/sitemap.xml"
+	sitemapPrefix = "/sitemaps/"
+	sitemapXMLNS  = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	// sitemapMaxEntries is the most URLs or sitemaps the protocol allows
+	// in one file.
+	sitemapMaxEntries = 50000
+)
+
+type sitemapURL struct {
+	Loc        string `xml:"loc"`
+	LastMod    string `xml:"lastmod"`
+	ChangeFreq string `xml:"changefreq"`
+	Priority   string `xml:"priority"`
+}
+
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name       `xml:"sitemapindex"`
+	XMLNS    string         `xml:"xmlns,attr"`
+	Sitemaps []sitemapEntry `xml:"sitemap"`
+}
+
+var changeFreqs = []string{"daily", "weekly", "monthly"}
+
+// parseSitemapPath splits a sitemap path into whether it is an index, its
+// number and whether it is gzipped:
+//
+//	/sitemap.xml             index 1
+//	/sitemaps/index-2.xml    index 2
+//	/sitemaps/7.xml.gz       sitemap 7, gzipped
+func parseSitemapPath(path string) (index bool, n int, gz bool, ok bool) {
+	path, gz = strings.CutSuffix(path, ".gz")
+	if path == sitemapPath {
+		return true, 1, gz, true
+	}
+
+	name, found := strings.CutPrefix(path, sitemapPrefix)
+	if !found {
+		return false, 0, false, false
+	}
+	name, found = strings.CutSuffix(name, ".xml")
+	if !found {
+		return false, 0, false, false
+	}
+	name, index = strings.CutPrefix(name, "index-")
+
+	n, err := strconv.Atoi(name)
+	if err != nil || n < 1 || strconv.Itoa(n) != name {
+		return false, 0, false, false
+	}
+	return index, n, gz, true
+}
+
+// serveSitemap serves the sitemap index pages and the sitemaps they list.
+// Index pages chain on to the next one and every sitemap lists generated
+// URLs, so the sitemaps never run out, and the same path always gives the
+// same URLs.
+func (t *trap) serveSitemap(w http.ResponseWriter, r *http.Request) {
+	index, n, gz, ok := parseSitemapPath(r.URL.Path)
+	// an index lists sitemaps up to n*per and links on to n+1, numbers that
+	// must not overflow.
+	if !ok || index && n >= math.MaxInt/t.cfg.Sitemap.PerIndex {
+		http.NotFound(w, r)
+		return
+	}
+
+	ev := t.hit(r, kindSitemap)
+	t.appendEvent(ev)
+
+	rng := pageRand(t.cfg.Secret, r.Host, r.URL.Path)
+	today := time.Now().Truncate(24 * time.Hour)
+	base := "http://" + r.Host
+	ext := ".xml"
+	if gz {
+		ext = ".xml.gz"
+	}
+
+	var doc any
+	if index {
+		per := t.cfg.Sitemap.PerIndex
+		idx := sitemapIndex{XMLNS: sitemapXMLNS}
+		for i := (n-1)*per + 1; i <= n*per; i++ {
+			idx.Sitemaps = append(idx.Sitemaps, sitemapEntry{
+				Loc:     fmt.Sprintf("%s%s%d%s", base, sitemapPrefix, i, ext),
+				LastMod: today.AddDate(0, 0, -rng.Intn(30)).Format(dateLayout),
+			})
+		}
+		// crawlers that follow nested indexes keep going.
+		idx.Sitemaps = append(idx.Sitemaps, sitemapEntry{
+			Loc:     fmt.Sprintf("%s%sindex-%d%s", base, sitemapPrefix, n+1, ext),
+			LastMod: today.Format(dateLayout),
+		})
+		doc = idx
+	} else {
+		links := generateLinks(rng, t.cfg.LinkMode, t.cfg.Domain, t.cfg.Sitemap.URLs)
+		t.emitLinks(ev, links)
+
+		set := sitemapURLSet{XMLNS: sitemapXMLNS}
+		for _, l := range links {
+			loc := l.URL
+			if strings.HasPrefix(loc, "/") {
+				loc = base + loc
+			}
+			set.URLs = append(set.URLs, sitemapURL{
+				Loc:        loc,
+				LastMod:    today.AddDate(0, 0, -rng.Intn(365)).Format(dateLayout),
+				ChangeFreq: changeFreqs[rng.Intn(len(changeFreqs))],
+				Priority:   fmt.Sprintf("%.1f", float64(rng.Intn(10)+1)/10),
+			})
+		}
+		doc = set
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		slog.Error("serveSitemap: failed to encode", "error", err)
+		http.Error(w, "Could not render sitemap.", http.StatusInternalServerError)
+		return
+	}
+
+	body := buf.Bytes()
+	w.Header().Set("Content-Type", "application/xml")
+	if gz {
+		var zbuf bytes.Buffer
+		zw := gzip.NewWriter(&zbuf)
+		_, _ = zw.Write(body)
+		_ = zw.Close()
+		body = zbuf.Bytes()
+		w.Header().Set("Content-Type", "application/gzip")
+	}
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set("Server", servers[rng.Intn(len(servers))])
+
+	written := t.write(w, r, body)
+	t.metrics.bytesServed.Add(int64(written))
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServeSitemapHugeIndex(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Sitemap.Enabled = true
+	tr := newTestTrap(t, cfg)
+	per := cfg.Sitemap.PerIndex
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", path, nil)
+		r.Host = "a.test"
+		tr.serveSitemap(w, r)
+		return w
+	}
+
+	for _, n := range []string{
+		fmt.Sprint(math.MaxInt),
+		fmt.Sprint(math.MaxInt / per),
+		"99999999999999999999999",
+	} {
+		if w := get(sitemapPrefix + "index-" + n + ".xml"); w.Code != http.StatusNotFound {
+			t.Errorf("index %s: status %d, want 404", n, w.Code)
+		}
+	}
+
+	// the last index that can be numbered lists every sitemap and the next
+	// index.
+	last := math.MaxInt/per - 1
+	w := get(fmt.Sprintf("%sindex-%d.xml", sitemapPrefix, last))
+	if w.Code != http.StatusOK {
+		t.Fatalf("index %d: status %d", last, w.Code)
+	}
+	var idx sitemapIndex
+	if err := xml.Unmarshal(w.Body.Bytes(), &idx); err != nil {
+		t.Fatal(err)
+	}
+	if len(idx.Sitemaps) != per+1 {
+		t.Fatalf("index %d lists %d sitemaps, want %d", last, len(idx.Sitemaps), per+1)
+	}
+	if want := fmt.Sprintf("%s%d.xml", sitemapPrefix, last*per); !strings.HasSuffix(idx.Sitemaps[per-1].Loc, want) {
+		t.Errorf("last sitemap %s, want one ending %s", idx.Sitemaps[per-1].Loc, want)
+	}
+	if want := fmt.Sprintf("index-%d.xml", last+1); !strings.HasSuffix(idx.Sitemaps[per].Loc, want) {
+		t.Errorf("next index %s, want one ending %s", idx.Sitemaps[per].Loc, want)
+	}
+
+	// sitemaps themselves are not numbered on, any number serves.
+	if w := get(fmt.Sprintf("%s%d.xml", sitemapPrefix, math.MaxInt)); w.Code != http.StatusOK {
+		t.Errorf("sitemap %d: status %d, want 200", math.MaxInt, w.Code)
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+// StatsRecorder counts crawler hits between flushes. Implementations must be
+// safe for concurrent use by the request handlers and the stats flusher.
+type StatsRecorder interface {
+	// Record increments the count for key by one.
+	Record(key string)
+	// Add increments the count for key by n.
+	Add(key string, n int)
+	// Snapshot returns a copy of the current counts.
+	Snapshot() map[string]int
+	// Reset clears the recorder and returns the counts it held. A Record
+	// racing with Reset is counted either in the returned map or in the
+	// fresh one, never lost.
+	Reset() map[string]int
+}
+
+const statsShards = 32
+
+// memoryStats is an in-memory StatsRecorder. Keys are spread over a fixed
+// number of mutex guarded shards so concurrent handlers rarely contend.
+type memoryStats struct {
+	shards [statsShards]statsShard
+}
+
+type statsShard struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func newMemoryStats() *memoryStats {
+	s := &memoryStats{}
+	for i := range s.shards {
+		s.shards[i].counts = map[string]int{}
+	}
+	return s
+}
+
+func (s *memoryStats) shard(key string) *statsShard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return &s.shards[h.Sum32()%statsShards]
+}
+
+func (s *memoryStats) Record(key string) {
+	s.Add(key, 1)
+}
+
+func (s *memoryStats) Add(key string, n int) {
+	sh := s.shard(key)
+	sh.mu.Lock()
+	sh.counts[key] += n
+	sh.mu.Unlock()
+}
+
+func (s *memoryStats) Snapshot() map[string]int {
+	out := map[string]int{}
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		for k, v := range sh.counts {
+			out[k] += v
+		}
+		sh.mu.Unlock()
+	}
+	return out
+}
+
+func (s *memoryStats) Reset() map[string]int {
+	out := map[string]int{}
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		counts := sh.counts
+		sh.counts = map[string]int{}
+		sh.mu.Unlock()
+
+		for k, v := range counts {
+			out[k] += v
+		}
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestMemoryStatsCounts(t *testing.T) {
+	s := newMemoryStats()
+	s.Record("Googlebot")
+	s.Record("Googlebot")
+	s.Add("Bingbot", 5)
+
+	got := s.Snapshot()
+	if got["Googlebot"] != 2 || got["Bingbot"] != 5 || len(got) != 2 {
+		t.Fatalf("Snapshot() = %v", got)
+	}
+
+	got = s.Reset()
+	if got["Googlebot"] != 2 || got["Bingbot"] != 5 || len(got) != 2 {
+		t.Fatalf("Reset() = %v", got)
+	}
+	if got := s.Snapshot(); len(got) != 0 {
+		t.Fatalf("Snapshot() after Reset = %v, want empty", got)
+	}
+}
+
+// TestMemoryStatsConcurrent races writers against Reset and Snapshot. Run it
+// with -race; every recorded hit must turn up in exactly one Reset or the
+// final snapshot.
+func TestMemoryStatsConcurrent(t *testing.T) {
+	const (
+		writers = 16
+		records = 2000
+	)
+
+	s := newMemoryStats()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		flushed = map[string]int{}
+		done    = make(chan struct{})
+		readers sync.WaitGroup
+	)
+
+	readers.Add(2)
+	go func() {
+		defer readers.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			counts := s.Reset()
+			mu.Lock()
+			for k, v := range counts {
+				flushed[k] += v
+			}
+			mu.Unlock()
+		}
+	}()
+	go func() {
+		defer readers.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			_ = s.Snapshot()
+		}
+	}()
+
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < records; i++ {
+				key := fmt.Sprintf("family-%d", i%10)
+				if i%2 == 0 {
+					s.Record(key)
+				} else {
+					s.Add(key, 1)
+				}
+			}
+		}(w)
+	}
+
+	wg.Wait()
+	close(done)
+	readers.Wait()
+
+	total := 0
+	for _, v := range flushed {
+		total += v
+	}
+	for _, v := range s.Snapshot() {
+		total += v
+	}
+
+	if want := writers * records; total != want {
+		t.Fatalf("recorded %d hits, Reset and Snapshot account for %d", want, total)
+	}
+}
@@ -0,0 +1,385 @@
+package main
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// statsMaxDays is the longest range a stats view covers.
+const statsMaxDays = 366
+
+// statsRange is the inclusive range of days a stats view covers.
+type statsRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// parseStatsRange reads the from and to query parameters as YYYY-MM-DD.
+// Without them the last seven days up to today are used. Ranges longer than
+// statsMaxDays are refused.
+func parseStatsRange(r *http.Request, now time.Time) (statsRange, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	rng := statsRange{From: today.AddDate(0, 0, -6), To: today}
+
+	if v := r.URL.Query().Get("from"); v != "" {
+		t, err := time.ParseInLocation(dateLayout, v, time.Local)
+		if err != nil {
+			return rng, err
+		}
+		rng.From = t
+	}
+
+	if v := r.URL.Query().Get("to"); v != "" {
+		t, err := time.ParseInLocation(dateLayout, v, time.Local)
+		if err != nil {
+			return rng, err
+		}
+		rng.To = t
+	}
+
+	if rng.From.After(rng.To) {
+		return rng, fmt.Errorf("from %s is after to %s", rng.From.Format(dateLayout), rng.To.Format(dateLayout))
+	}
+	if rng.Days() > statsMaxDays {
+		return rng, fmt.Errorf("range of %d days is longer than %d", rng.Days(), statsMaxDays)
+	}
+
+	return rng, nil
+}
+
+// Days is the number of days in the range.
+func (rng statsRange) Days() int {
+	// the range is between local midnights, a day can be 23 or 25 hours.
+	return int(rng.To.Sub(rng.From).Round(24*time.Hour)/(24*time.Hour)) + 1
+}
+
+// Contains reports whether t falls on one of the days in the range.
+func (rng statsRange) Contains(t time.Time) bool {
+	t = t.In(time.Local)
+	return !t.Before(rng.From) && t.Before(rng.To.AddDate(0, 0, 1))
+}
+
+type statsDay struct {
+	Date       string         `json:"date"`
+	Total      int            `json:"total"`
+	UserAgents map[string]int `json:"user_agents"`
+	Families   map[string]int `json:"families"`
+}
+
+// familyStats is the hits of one crawler family with a drill-down into the
+// user agents seen for it.
+type familyStats struct {
+	Family
+	Total      int            `json:"total"`
+	UserAgents map[string]int `json:"user_agents"`
+	// Verification counts hits by DNS verification result.
+	Verification map[string]int `json:"verification"`
+	// Robots counts hits that broke the robots.txt policy by rule and
+	// RobotsFetched the times robots.txt itself was read.
+	Robots        map[string]int `json:"robots"`
+	RobotsFetched int            `json:"robots_fetched"`
+	// IPs, Networks and ASNs are how many distinct client addresses, /24
+	// or /48 networks and autonomous systems the hits came from.
+	IPs      int `json:"ips"`
+	Networks int `json:"networks"`
+	ASNs     int `json:"asns"`
+
+	seen map[string]bool
+}
+
+// asnStats is the crawler hits from one autonomous system.
+type asnStats struct {
+	ASN   uint32 `json:"asn"`
+	Org   string `json:"org,omitempty"`
+	Total int    `json:"total"`
+}
+
+// endlessStats summarises the endless pages streamed to one crawler family.
+type endlessStats struct {
+	Streams     int           `json:"streams"`
+	Bytes       int64         `json:"bytes"`
+	MaxBytes    int64         `json:"max_bytes"`
+	MaxDuration time.Duration `json:"max_duration"`
+}
+
+type statsReport struct {
+	From       string                   `json:"from"`
+	To         string                   `json:"to"`
+	Total      int                      `json:"total"`
+	Kinds      map[string]int           `json:"kinds"`
+	UserAgents map[string]int           `json:"user_agents"`
+	Families   map[string]*familyStats  `json:"families"`
+	Endless    map[string]*endlessStats `json:"endless"`
+	// IPs, Networks and ASNs count crawler hits by client address, by /24
+	// or /48 network and by autonomous system.
+	IPs      map[string]int       `json:"ips"`
+	Networks map[string]int       `json:"networks"`
+	ASNs     map[string]*asnStats `json:"asns"`
+	// BehaviorBots counts, by user agent, hits from clients that did not
+	// claim to be crawlers but were flagged by their behaviour.
+	BehaviorBots map[string]int `json:"behavior_bots"`
+	Days         []*statsDay    `json:"days"`
+}
+
+// buildStatsReport aggregates the crawler events in the range from the
+// event log. A non empty family limits the report to that crawler family.
+func buildStatsReport(events *EventStore, rng statsRange, family string) (*statsReport, error) {
+	report := &statsReport{
+		From:       rng.From.Format(dateLayout),
+		To:         rng.To.Format(dateLayout),
+		Kinds:      map[string]int{},
+		UserAgents: map[string]int{},
+		Families:   map[string]*familyStats{},
+		Endless:    map[string]*endlessStats{},
+		IPs:        map[string]int{},
+		Networks:   map[string]int{},
+		ASNs:       map[string]*asnStats{},
+
+		BehaviorBots: map[string]int{},
+		Days:         []*statsDay{},
+	}
+	days := map[string]*statsDay{}
+
+	err := events.ScanSince(rng.From, func(ev Event) error {
+		if !rng.Contains(ev.Time) {
+			return nil
+		}
+		if !ev.Crawler {
+			if ev.Behavior.Bot && family == "" {
+				report.BehaviorBots[ev.UserAgent]++
+			}
+			return nil
+		}
+
+		fam := crawlerFamily(ev.UserAgent)
+		if family != "" && fam.Name != family {
+			return nil
+		}
+
+		date := ev.Time.In(time.Local).Format(dateLayout)
+		day, ok := days[date]
+		if !ok {
+			day = &statsDay{
+				Date:       date,
+				UserAgents: map[string]int{},
+				Families:   map[string]int{},
+			}
+			days[date] = day
+			report.Days = append(report.Days, day)
+		}
+
+		day.Total++
+		day.UserAgents[ev.UserAgent]++
+		day.Families[fam.Name]++
+
+		fs, ok := report.Families[fam.Name]
+		if !ok {
+			fs = &familyStats{
+				Family:       fam,
+				UserAgents:   map[string]int{},
+				Verification: map[string]int{},
+				Robots:       map[string]int{},
+				seen:         map[string]bool{},
+			}
+			report.Families[fam.Name] = fs
+		}
+		fs.Total++
+		fs.UserAgents[ev.UserAgent]++
+		if ev.Verification != "" {
+			fs.Verification[ev.Verification]++
+		}
+		if ev.Robots != "" {
+			fs.Robots[ev.Robots]++
+		}
+		if ev.Kind == kindRobots {
+			fs.RobotsFetched++
+		}
+
+		ip := eventClientIP(ev)
+		network := clientNetwork(ip)
+		report.IPs[ip]++
+		report.Networks[network]++
+		fs.count("ip|"+ip, &fs.IPs)
+		fs.count("net|"+network, &fs.Networks)
+		if ev.ASN != 0 {
+			key := fmt.Sprintf("AS%d", ev.ASN)
+			as, ok := report.ASNs[key]
+			if !ok {
+				as = &asnStats{ASN: ev.ASN, Org: ev.ASOrg}
+				report.ASNs[key] = as
+			}
+			as.Total++
+			fs.count("asn|"+key, &fs.ASNs)
+		}
+
+		report.Total++
+		report.UserAgents[ev.UserAgent]++
+		report.Kinds[cmp.Or(ev.Kind, kindPage)]++
+
+		if ev.Kind == kindEndless {
+			es, ok := report.Endless[fam.Name]
+			if !ok {
+				es = &endlessStats{}
+				report.Endless[fam.Name] = es
+			}
+			es.Streams++
+			es.Bytes += ev.Bytes
+			es.MaxBytes = max(es.MaxBytes, ev.Bytes)
+			es.MaxDuration = max(es.MaxDuration, ev.Duration)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(report.Days, func(i, j int) bool {
+		return report.Days[i].Date < report.Days[j].Date
+	})
+
+	return report, nil
+}
+
+// count increments n the first time key is seen for the family.
+func (fs *familyStats) count(key string, n *int) {
+	if !fs.seen[key] {
+		fs.seen[key] = true
+		*n++
+	}
+}
+
+// eventClientIP is the client address of ev. Events from before trusted
+// proxies were resolved only have the connected peer.
+func eventClientIP(ev Event) string {
+	if ev.ClientIP != "" {
+		return ev.ClientIP
+	}
+	if addr, ok := parseHostAddr(ev.RemoteAddr); ok {
+		return addr.String()
+	}
+	return ev.RemoteAddr
+}
+
+func statsJSONHandler(reports *statsReports) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rng, err := parseStatsRange(r, time.Now())
+		if err != nil {
+			http.Error(w, "Invalid date range.", http.StatusBadRequest)
+			return
+		}
+
+		report, err := reports.stats(rng, r.URL.Query().Get("family"))
+		if err != nil {
+			slog.Error("statsJSONHandler: failed to read events", "error", err)
+			http.Error(w, "Could not read stats.", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(report)
+	}
+}
+
+// countRow is one line of a ranked table.
+type countRow struct {
+	Key   string
+	Count int
+}
+
+// topCounts returns the n largest counts, largest first. n <= 0 returns
+// all of them.
+func topCounts(m map[string]int, n int) []countRow {
+	rows := make([]countRow, 0, len(m))
+	for k, v := range m {
+		rows = append(rows, countRow{k, v})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Count != rows[j].Count {
+			return rows[i].Count > rows[j].Count
+		}
+		return rows[i].Key < rows[j].Key
+	})
+	if n > 0 && len(rows) > n {
+		rows = rows[:n]
+	}
+	return rows
+}
+
+// asnCounts flattens the ASN stats into "AS123 Org" keyed counts.
+func asnCounts(asns map[string]*asnStats) map[string]int {
+	counts := make(map[string]int, len(asns))
+	for key, as := range asns {
+		if as.Org != "" {
+			key += " " + as.Org
+		}
+		counts[key] = as.Total
+	}
+	return counts
+}
+
+// statsClientsHandler exports crawler hits in the range as CSV, grouped by
+// client IP, network or ASN as chosen by the by query parameter.
+func statsClientsHandler(reports *statsReports) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rng, err := parseStatsRange(r, time.Now())
+		if err != nil {
+			http.Error(w, "Invalid date range.", http.StatusBadRequest)
+			return
+		}
+
+		by := cmp.Or(r.URL.Query().Get("by"), "ip")
+		if by != "ip" && by != "network" && by != "asn" {
+			http.Error(w, "Unknown grouping.", http.StatusBadRequest)
+			return
+		}
+
+		report, err := reports.stats(rng, r.URL.Query().Get("family"))
+		if err != nil {
+			slog.Error("statsClientsHandler: failed to read events", "error", err)
+			http.Error(w, "Could not read stats.", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-%s-%s.csv"`, by, report.From, report.To))
+
+		cw := csv.NewWriter(w)
+		switch by {
+		case "ip":
+			_ = cw.Write([]string{"ip", "count"})
+			for _, row := range topCounts(report.IPs, 0) {
+				_ = cw.Write([]string{row.Key, strconv.Itoa(row.Count)})
+			}
+		case "network":
+			_ = cw.Write([]string{"network", "count"})
+			for _, row := range topCounts(report.Networks, 0) {
+				_ = cw.Write([]string{row.Key, strconv.Itoa(row.Count)})
+			}
+		case "asn":
+			_ = cw.Write([]string{"asn", "org", "count"})
+			asns := make([]*asnStats, 0, len(report.ASNs))
+			for _, as := range report.ASNs {
+				asns = append(asns, as)
+			}
+			sort.Slice(asns, func(i, j int) bool {
+				if asns[i].Total != asns[j].Total {
+					return asns[i].Total > asns[j].Total
+				}
+				return asns[i].ASN < asns[j].ASN
+			})
+			for _, as := range asns {
+				_ = cw.Write([]string{strconv.FormatUint(uint64(as.ASN), 10), as.Org, strconv.Itoa(as.Total)})
+			}
+		}
+		cw.Flush()
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// statsCacheTTL is how long a built report is served before the event
+	// log is scanned for it again.
+	statsCacheTTL = time.Minute
+	// statsCacheSize caps the reports kept, one per view, range and family.
+	statsCacheSize = 64
+)
+
+// statsReports builds the stats and session reports for the stats views
+// and keeps each for statsCacheTTL, so reloading a view or polling the
+// JSON does not rescan up to a year of events every time. Builds run one
+// at a time: requests for many different ranges queue up behind each other
+// rather than scanning the log in parallel.
+type statsReports struct {
+	events *EventStore
+
+	// build is held while a report is built.
+	build sync.Mutex
+
+	mu      sync.Mutex
+	entries map[string]statsCacheEntry
+}
+
+type statsCacheEntry struct {
+	built  time.Time
+	report any
+}
+
+func newStatsReports(events *EventStore) *statsReports {
+	return &statsReports{
+		events:  events,
+		entries: map[string]statsCacheEntry{},
+	}
+}
+
+// stats returns the stats report for the range and family.
+func (rs *statsReports) stats(rng statsRange, family string) (*statsReport, error) {
+	report, err := rs.get("stats", rng, family, func() (any, error) {
+		return buildStatsReport(rs.events, rng, family)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report.(*statsReport), nil
+}
+
+// sessions returns the sessions in the range for the family.
+func (rs *statsReports) sessions(rng statsRange, family string) ([]*sessionSummary, error) {
+	sessions, err := rs.get("sessions", rng, family, func() (any, error) {
+		return buildSessionReport(rs.events, rng, family)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sessions.([]*sessionSummary), nil
+}
+
+// get returns the cached report of the view for the range and family, or
+// builds it. Reports are shared between requests and must not be changed.
+func (rs *statsReports) get(view string, rng statsRange, family string, build func() (any, error)) (any, error) {
+	key := view + "\x00" + rng.From.Format(dateLayout) + "\x00" + rng.To.Format(dateLayout) + "\x00" + family
+	if report, ok := rs.lookup(key); ok {
+		return report, nil
+	}
+
+	rs.build.Lock()
+	defer rs.build.Unlock()
+
+	// the request this one queued behind may have built it.
+	if report, ok := rs.lookup(key); ok {
+		return report, nil
+	}
+
+	report, err := build()
+	if err != nil {
+		return nil, err
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	if len(rs.entries) >= statsCacheSize {
+		evictOldest(rs.entries, len(rs.entries)-statsCacheSize+1, func(e statsCacheEntry) time.Time { return e.built })
+	}
+	rs.entries[key] = statsCacheEntry{built: time.Now(), report: report}
+	return report, nil
+}
+
+func (rs *statsReports) lookup(key string) (any, bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	entry, ok := rs.entries[key]
+	if !ok || time.Since(entry.built) > statsCacheTTL {
+		return nil, false
+	}
+	return entry.report, true
+}
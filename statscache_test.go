@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStatsReportsCache(t *testing.T) {
+	events, err := OpenEventStore(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer events.Close()
+
+	const ua = "Googlebot/2.1 (+http://www.google.com/bot.html)"
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	rng := statsRange{From: today, To: today}
+	reports := newStatsReports(events)
+	hit := func() {
+		if err := events.Append(Event{Time: now, UserAgent: ua, Crawler: true}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	total := func() int {
+		report, err := reports.stats(rng, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return report.Total
+	}
+
+	hit()
+	if got := total(); got != 1 {
+		t.Fatalf("Total = %d, want 1", got)
+	}
+	hit()
+	if got := total(); got != 1 {
+		t.Fatalf("Total = %d, want the cached 1", got)
+	}
+
+	for key, entry := range reports.entries {
+		entry.built = entry.built.Add(-statsCacheTTL - time.Second)
+		reports.entries[key] = entry
+	}
+	if got := total(); got != 2 {
+		t.Fatalf("Total = %d, want 2 once the cached report expired", got)
+	}
+
+	for i := 0; i < statsCacheSize+10; i++ {
+		if _, err := reports.stats(rng, fmt.Sprintf("family-%d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(reports.entries) > statsCacheSize {
+		t.Fatalf("caching %d reports, cap is %d", len(reports.entries), statsCacheSize)
+	}
+}
+
+func TestStatsReportsBuildOnce(t *testing.T) {
+	reports := newStatsReports(nil)
+
+	var builds atomic.Int32
+	build := func() (any, error) {
+		builds.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return &statsReport{}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := reports.get("stats", statsRange{}, "", build); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := builds.Load(); n != 1 {
+		t.Fatalf("report built %d times for concurrent requests, want once", n)
+	}
+}
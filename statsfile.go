@@ -0,0 +1,190 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var statsFileHeader = []string{"family", "user_agent", "count"}
+
+// statsFilePath returns the day file the stats for t are written to,
+// LOG_FILE_DIR/YYYY/Month/D.csv.
+func statsFilePath(fileDir string, t time.Time) string {
+	return filepath.Join(fileDir, fmt.Sprint(t.Year()), fmt.Sprint(t.Month()), fmt.Sprint(t.Day())+".csv")
+}
+
+// readStatsFile parses a day file into user agent counts. A missing file is
+// an empty set of counts. Older files without a header row or without the
+// family column are read the same way; the family is always derived from
+// the user agent again when the file is written.
+func readStatsFile(path string) (map[string]int, error) {
+	counts := map[string]int{}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return counts, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return parseStats(file, path)
+}
+
+func parseStats(r io.Reader, name string) (map[string]int, error) {
+	counts := map[string]int{}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	// older files wrote user agents between quotes without escaping.
+	cr.LazyQuotes = true
+
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if line == 1 && (record[0] == "family" || record[0] == "user_agent") {
+			continue
+		}
+
+		// rows are family,user_agent,count or the older user_agent,count.
+		if len(record) == 3 {
+			record = record[1:]
+		}
+		if len(record) != 2 {
+			slog.Warn("readStatsFile: skipping malformed row", "file", name, "line", line)
+			continue
+		}
+
+		n, err := strconv.Atoi(record[1])
+		if err != nil {
+			slog.Warn("readStatsFile: skipping row with invalid count", "file", name, "line", line, "error", err)
+			continue
+		}
+
+		counts[record[0]] += n
+	}
+
+	return counts, nil
+}
+
+// mergeStatsFile adds counts to the day file at path. Keys are matched
+// exactly. The result is written to a temporary file that is renamed over
+// the original so a crash mid-write never leaves a truncated file behind.
+func mergeStatsFile(path string, counts map[string]int) error {
+	existing, err := readStatsFile(path)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", path, err)
+	}
+
+	for k, v := range counts {
+		existing[k] += v
+	}
+
+	return writeStatsFile(path, existing)
+}
+
+func writeStatsFile(path string, counts map[string]int) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	// removing after a successful rename is a no-op.
+	defer os.Remove(tmp.Name())
+
+	type row struct{ family, ua string }
+	rows := make([]row, 0, len(counts))
+	for ua := range counts {
+		rows = append(rows, row{crawlerFamily(ua).Name, ua})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].family != rows[j].family {
+			return rows[i].family < rows[j].family
+		}
+		return rows[i].ua < rows[j].ua
+	})
+
+	w := csv.NewWriter(tmp)
+	_ = w.Write(statsFileHeader)
+	for _, r := range rows {
+		_ = w.Write([]string{r.family, r.ua, strconv.Itoa(counts[r.ua])})
+	}
+	w.Flush()
+
+	if err := w.Error(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// familyRow is a crawler family's total in a day file with the user agents
+// that make it up.
+type familyRow struct {
+	Name       string
+	Total      int
+	UserAgents []uaRow
+}
+
+type uaRow struct {
+	UserAgent string
+	Count     int
+}
+
+// groupByFamily rolls user agent counts up into families, largest first.
+func groupByFamily(counts map[string]int) []*familyRow {
+	byName := map[string]*familyRow{}
+	var rows []*familyRow
+	for ua, n := range counts {
+		name := crawlerFamily(ua).Name
+		fr, ok := byName[name]
+		if !ok {
+			fr = &familyRow{Name: name}
+			byName[name] = fr
+			rows = append(rows, fr)
+		}
+		fr.Total += n
+		fr.UserAgents = append(fr.UserAgents, uaRow{UserAgent: ua, Count: n})
+	}
+
+	for _, fr := range rows {
+		sort.Slice(fr.UserAgents, func(i, j int) bool {
+			return fr.UserAgents[i].Count > fr.UserAgents[j].Count
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Total != rows[j].Total {
+			return rows[i].Total > rows[j].Total
+		}
+		return rows[i].Name < rows[j].Name
+	})
+
+	return rows
+}
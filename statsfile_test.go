@@ -0,0 +1,162 @@
+package main
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMergeStatsFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string // file contents, none when empty
+		counts   map[string]int
+		want     map[string]int
+	}{
+		{
+			name:   "new file",
+			counts: map[string]int{"Googlebot/2.1": 3},
+			want:   map[string]int{"Googlebot/2.1": 3},
+		},
+		{
+			name:     "exact key match",
+			existing: "family,user_agent,count\nGooglebot,Googlebot,2\nGooglebot,Googlebot-Image,5\n",
+			counts:   map[string]int{"Googlebot": 1},
+			want:     map[string]int{"Googlebot": 3, "Googlebot-Image": 5},
+		},
+		{
+			name:     "prefix is a different key",
+			existing: "family,user_agent,count\nGooglebot,Googlebot-Image,5\n",
+			counts:   map[string]int{"Googlebot": 1},
+			want:     map[string]int{"Googlebot": 1, "Googlebot-Image": 5},
+		},
+		{
+			name:     "commas and quotes",
+			existing: "family,user_agent,count\nOther,\"Mozilla/5.0 (compatible; \"\"x\"\", y)\",4\n",
+			counts:   map[string]int{`Mozilla/5.0 (compatible; "x", y)`: 1, `a,b "c"`: 2},
+			want:     map[string]int{`Mozilla/5.0 (compatible; "x", y)`: 5, `a,b "c"`: 2},
+		},
+		{
+			name:     "no header",
+			existing: "Googlebot,Googlebot/2.1,7\n",
+			counts:   map[string]int{"Googlebot/2.1": 1},
+			want:     map[string]int{"Googlebot/2.1": 8},
+		},
+		{
+			name:     "no family column",
+			existing: "user_agent,count\nGooglebot/2.1,7\nbingbot,2\n",
+			counts:   map[string]int{"bingbot": 1},
+			want:     map[string]int{"Googlebot/2.1": 7, "bingbot": 3},
+		},
+		{
+			name:     "no header and no family column",
+			existing: "Googlebot/2.1,7\n",
+			counts:   map[string]int{"Googlebot/2.1": 1},
+			want:     map[string]int{"Googlebot/2.1": 8},
+		},
+		{
+			name:     "malformed rows are skipped",
+			existing: "family,user_agent,count\nGooglebot,Googlebot/2.1,lots\nonly-one-field\nbingbot,bingbot,2\n",
+			counts:   map[string]int{},
+			want:     map[string]int{"bingbot": 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "2024", "January", "1.csv")
+			if tt.existing != "" {
+				if err := os.MkdirAll(filepath.Dir(path), 0o777); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(tt.existing), 0o666); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := mergeStatsFile(path, tt.counts); err != nil {
+				t.Fatalf("mergeStatsFile: %v", err)
+			}
+
+			got, err := readStatsFile(path)
+			if err != nil {
+				t.Fatalf("readStatsFile: %v", err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+
+			// the rewritten file is read back the same way.
+			if err := mergeStatsFile(path, nil); err != nil {
+				t.Fatalf("mergeStatsFile: %v", err)
+			}
+			again, err := readStatsFile(path)
+			if err != nil {
+				t.Fatalf("readStatsFile: %v", err)
+			}
+			if !maps.Equal(again, tt.want) {
+				t.Fatalf("after round trip got %v, want %v", again, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteStatsFileHeader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "1.csv")
+	if err := writeStatsFile(path, map[string]int{"Googlebot/2.1": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first, _, _ := strings.Cut(string(data), "\n"); first != strings.Join(statsFileHeader, ",") {
+		t.Fatalf("first line = %q, want the header", first)
+	}
+}
+
+func TestWriteStatsFileRename(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "1.csv")
+
+	if err := writeStatsFile(path, map[string]int{"Googlebot/2.1": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mergeStatsFile(path, map[string]int{"Googlebot/2.1": 1}); err != nil {
+		t.Fatal(err)
+	}
+	assertOnlyFiles(t, dir, "1.csv")
+
+	// a failed rename leaves whatever was at path alone and cleans up the
+	// temporary file.
+	blocked := filepath.Join(dir, "2.csv")
+	if err := os.MkdirAll(filepath.Join(blocked, "keep"), 0o777); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeStatsFile(blocked, map[string]int{"Googlebot/2.1": 1}); err == nil {
+		t.Fatal("writeStatsFile over a directory succeeded")
+	}
+	if _, err := os.Stat(filepath.Join(blocked, "keep")); err != nil {
+		t.Fatalf("original at path was touched: %v", err)
+	}
+	assertOnlyFiles(t, dir, "1.csv", "2.csv")
+}
+
+func assertOnlyFiles(t *testing.T, dir string, want ...string) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.Name())
+	}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Fatalf("files in %s = %v, want %v", dir, got, want)
+	}
+}
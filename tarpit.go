@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// TarpitConfig controls slow responses.
+type TarpitConfig struct {
+	// Enabled turns on drip feeding pages to clients.
+	Enabled bool `yaml:"enabled"`
+	// ChunkSize is the number of bytes written between delays.
+	ChunkSize int `yaml:"chunk_size"`
+	// MinDelay and MaxDelay bound the random pause after each chunk.
+	MinDelay time.Duration `yaml:"min_delay"`
+	MaxDelay time.Duration `yaml:"max_delay"`
+	// MaxConns caps the slow responses in flight across all clients.
+	MaxConns int `yaml:"max_connections"`
+	// MaxConnsPerIP caps the slow responses in flight for one client.
+	MaxConnsPerIP int `yaml:"max_connections_per_ip"`
+}
+
+// tarpit streams responses slowly while keeping the number of slow
+// responses bounded, globally and per client, so a crawler cannot make us
+// hold more goroutines and sockets than we planned for. Requests over
+// budget are answered at full speed instead.
+type tarpit struct {
+	cfg   TarpitConfig
+	slots chan struct{}
+
+	mu    sync.Mutex
+	perIP map[string]int
+}
+
+func newTarpit(cfg TarpitConfig) *tarpit {
+	return &tarpit{
+		cfg:   cfg,
+		slots: make(chan struct{}, cfg.MaxConns),
+		perIP: map[string]int{},
+	}
+}
+
+// acquire reserves a slow response for ip. ok is false when the global or
+// the client's budget is spent. release must be called once the response
+// is done.
+func (tp *tarpit) acquire(ip string) (release func(), ok bool) {
+	select {
+	case tp.slots <- struct{}{}:
+	default:
+		return nil, false
+	}
+
+	tp.mu.Lock()
+	if tp.perIP[ip] >= tp.cfg.MaxConnsPerIP {
+		tp.mu.Unlock()
+		<-tp.slots
+		return nil, false
+	}
+	tp.perIP[ip]++
+	tp.mu.Unlock()
+
+	return func() {
+		tp.mu.Lock()
+		tp.perIP[ip]--
+		if tp.perIP[ip] == 0 {
+			delete(tp.perIP, ip)
+		}
+		tp.mu.Unlock()
+		<-tp.slots
+	}, true
+}
+
+// write sends content in chunks, flushing and pausing a random delay after
+// each one. It stops early when ctx is done, which happens when the client
+// goes away.
+func (tp *tarpit) write(ctx context.Context, w http.ResponseWriter, content []byte) (int, error) {
+	flusher, _ := w.(http.Flusher)
+
+	var timer *time.Timer
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	written := 0
+	for written < len(content) {
+		end := min(written+tp.cfg.ChunkSize, len(content))
+
+		n, err := w.Write(content[written:end])
+		written += n
+		if err != nil {
+			return written, err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		if written == len(content) {
+			break
+		}
+
+		if timer == nil {
+			timer = time.NewTimer(tp.delay())
+		} else {
+			timer.Reset(tp.delay())
+		}
+		select {
+		case <-ctx.Done():
+			return written, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return written, nil
+}
+
+func (tp *tarpit) delay() time.Duration {
+	spread := tp.cfg.MaxDelay - tp.cfg.MinDelay
+	if spread <= 0 {
+		return tp.cfg.MinDelay
+	}
+	return tp.cfg.MinDelay + time.Duration(rand.Int63n(int64(spread)))
+}
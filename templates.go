@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// partials are the blocks every layout is parsed with.
+//
+//go:embed templates/partials.tmpl
+var partials string
+
+// page is what a layout is rendered with. Everything in it is escaped by
+// html/template, including the name taken from the requested host.
+type page struct {
+	// Title is the generated headline of the page.
+	Title string
+	// Name is the page name derived from the host and path.
+	Name string
+	// Image is the URL of the page's image.
+	Image    string
+	Sections []section
+	Canary   canary
+	Links    []link
+	// Hidden is the invisible link only crawlers follow.
+	Hidden link
+	// Feeds advertises the RSS and Atom feeds in the page head.
+	Feeds bool
+}
+
+// templateCheckURL is rendered by every layout when it is loaded to make sure
+// it links on.
+const templateCheckURL = "/gridlock-template-check"
+
+// templateRegistry holds the page layouts a host can be given. Every layout
+// is filled from the same page model so only the markup around the content
+// differs between them.
+type templateRegistry struct {
+	names []string
+	pages map[string]*template.Template
+	// endless holds the built in blocks the endless page is streamed with.
+	endless *template.Template
+}
+
+// loadTemplates reads the embedded layouts and then any .html files in dir,
+// which replace embedded layouts of the same name or add new ones. A non
+// empty only limits the registry to the named layouts.
+func loadTemplates(dir string, only []string) (*templateRegistry, error) {
+	reg := &templateRegistry{pages: map[string]*template.Template{}}
+
+	endless, err := template.New("endless").Parse(partials)
+	if err != nil {
+		return nil, fmt.Errorf("template partials: %w", err)
+	}
+	reg.endless = endless
+
+	if err := reg.load(templateFS, "templates"); err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		if err := reg.load(os.DirFS(dir), "."); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(only) > 0 {
+		pages := map[string]*template.Template{}
+		for _, name := range only {
+			page, ok := reg.pages[name]
+			if !ok {
+				return nil, fmt.Errorf("template %q not found", name)
+			}
+			pages[name] = page
+		}
+		reg.pages = pages
+	}
+
+	for name := range reg.pages {
+		reg.names = append(reg.names, name)
+	}
+	slices.Sort(reg.names)
+
+	return reg, nil
+}
+
+func (reg *templateRegistry) load(fsys fs.FS, dir string) error {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.html"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimSuffix(path.Base(file), ".html")
+		tmpl, err := template.New(name).Parse(partials)
+		if err == nil {
+			tmpl, err = tmpl.Parse(string(data))
+		}
+		if err != nil {
+			return fmt.Errorf("template %s: %w", file, err)
+		}
+
+		// a layout without links would be a dead end in the graph.
+		var buf bytes.Buffer
+		check := page{Links: []link{{URL: templateCheckURL, Title: "check"}}}
+		if err := tmpl.Execute(&buf, check); err != nil {
+			return fmt.Errorf("template %s: %w", file, err)
+		}
+		if !bytes.Contains(buf.Bytes(), []byte(templateCheckURL)) {
+			return fmt.Errorf("template %s: does not render .Links", file)
+		}
+
+		reg.pages[name] = tmpl
+	}
+	return nil
+}
+
+// forHost returns the name and layout used on host. The choice is derived
+// from the secret and host so a host keeps its layout across pages and
+// restarts.
+func (reg *templateRegistry) forHost(secret, host string) (string, *template.Template) {
+	seed := uint64(pageSeed(secret, host, ""))
+	name := reg.names[seed%uint64(len(reg.names))]
+	return name, reg.pages[name]
+}
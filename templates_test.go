@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadTemplates(t *testing.T) {
+	tests := []struct {
+		name    string
+		layout  string
+		wantErr string
+	}{
+		{name: "ranges over links", layout: `<p>{{.Name}}</p>{{range .Links}}<a href="{{.URL}}">{{.Title}}</a>{{end}}`},
+		{name: "uses the links block", layout: `<p>{{.Name}}</p>{{template "links" .}}`},
+		{name: "replaces the links block", layout: `{{define "links"}}<ol>{{range .Links}}<li><a href="{{.URL}}">{{.Title}}</a></li>{{end}}</ol>{{end}}{{template "links" .}}`},
+		{name: "no links", layout: `<p>{{.Name}}</p>`, wantErr: "does not render .Links"},
+		{name: "syntax error", layout: `<p>{{.Name}</p>{{template "links" .}}`, wantErr: "bad character"},
+		{name: "unknown field", layout: `<p>{{.Nmae}}</p>{{template "links" .}}`, wantErr: "can't evaluate field Nmae"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "mine.html"), []byte(tt.layout), 0o666); err != nil {
+				t.Fatal(err)
+			}
+
+			reg, err := loadTemplates(dir, []string{"mine"})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("loadTemplates error = %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, tmpl := reg.forHost("secret", "a.test")
+			var buf bytes.Buffer
+			p := page{Links: []link{{URL: "/p/one.html", Title: "One & <two>"}}}
+			if err := tmpl.Execute(&buf, p); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(buf.String(), "One &amp; &lt;two&gt;") {
+				t.Fatalf("link title not escaped in %s", buf.String())
+			}
+		})
+	}
+}
+
+func TestLoadTemplatesOverride(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "worm.html"), []byte(`override {{template "links" .}}`), 0o666); err != nil {
+		t.Fatal(err)
+	}
+
+	reg, err := loadTemplates(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(reg.names, " "); got != "blog docs forum product wiki worm" {
+		t.Fatalf("layouts %s", got)
+	}
+
+	var buf bytes.Buffer
+	if err := reg.pages["worm"].Execute(&buf, page{}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "override") {
+		t.Fatalf("built in worm layout not replaced: %s", buf.String())
+	}
+
+	if _, err := loadTemplates("", []string{"nope"}); err == nil {
+		t.Fatal("unknown layout accepted")
+	}
+}
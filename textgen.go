@@ -0,0 +1,168 @@
+package main
+
+import (
+	_ "embed"
+	"math/rand"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:embed corpus.txt
+var corpus string
+
+// markovOrder is the number of preceding words used to choose the next one.
+const markovOrder = 2
+
+type markovKey [markovOrder]string
+
+// textGenerator produces plausible looking but meaningless prose from a
+// word level Markov chain built over the bundled corpus.
+type textGenerator struct {
+	starts []markovKey
+	chain  map[markovKey][]string
+	words  []string
+	// sources are the sentences of the corpus, which Sentence avoids
+	// repeating word for word.
+	sources map[string]bool
+}
+
+var prose = newTextGenerator(corpus)
+
+func newTextGenerator(corpus string) *textGenerator {
+	g := &textGenerator{chain: map[markovKey][]string{}, sources: map[string]bool{}}
+
+	words := strings.Fields(corpus)
+	g.words = words
+
+	start := 0
+	for i, word := range words {
+		if endsSentence(word) {
+			g.sources[strings.Join(words[start:i+1], " ")] = true
+			start = i + 1
+		}
+	}
+
+	for i := 0; i+markovOrder < len(words); i++ {
+		var key markovKey
+		copy(key[:], words[i:i+markovOrder])
+
+		if i == 0 || endsSentence(words[i-1]) {
+			g.starts = append(g.starts, key)
+		}
+
+		g.chain[key] = append(g.chain[key], words[i+markovOrder])
+	}
+
+	return g
+}
+
+func endsSentence(word string) bool {
+	return strings.HasSuffix(word, ".") || strings.HasSuffix(word, "!") || strings.HasSuffix(word, "?")
+}
+
+// sentenceAttempts bounds how many walks Sentence makes looking for one
+// that is not a sentence of the corpus.
+const sentenceAttempts = 20
+
+// Sentence walks the chain from a random sentence start until it reaches a
+// word ending a sentence. Walks that reproduce a corpus sentence are thrown
+// away, so pages cannot be matched back to the corpus.
+func (g *textGenerator) Sentence(rng *rand.Rand) string {
+	sentence := g.walk(rng)
+	for i := 1; i < sentenceAttempts && g.sources[sentence]; i++ {
+		sentence = g.walk(rng)
+	}
+	return sentence
+}
+
+func (g *textGenerator) walk(rng *rand.Rand) string {
+	const maxWords = 40
+
+	key := g.starts[rng.Intn(len(g.starts))]
+	out := append([]string{}, key[:]...)
+
+	for len(out) < maxWords && !endsSentence(out[len(out)-1]) {
+		next, ok := g.chain[key]
+		if !ok {
+			break
+		}
+
+		word := next[rng.Intn(len(next))]
+		out = append(out, word)
+
+		copy(key[:], key[1:])
+		key[markovOrder-1] = word
+	}
+
+	sentence := strings.Join(out, " ")
+	if !endsSentence(sentence) {
+		sentence = strings.TrimRight(sentence, ",;:") + "."
+	}
+
+	return sentence
+}
+
+// Paragraph returns between three and seven sentences.
+func (g *textGenerator) Paragraph(rng *rand.Rand) string {
+	n := 3 + rng.Intn(5)
+	sentences := make([]string, n)
+	for i := range sentences {
+		sentences[i] = g.Sentence(rng)
+	}
+	return strings.Join(sentences, " ")
+}
+
+// Phrase returns between min and max words picked from the corpus, title
+// cased, for use as headings and list items.
+func (g *textGenerator) Phrase(rng *rand.Rand, min, max int) string {
+	n := min + rng.Intn(max-min+1)
+	out := make([]string, 0, n)
+	for len(out) < n {
+		word := strings.TrimFunc(g.words[rng.Intn(len(g.words))], func(r rune) bool {
+			return !unicode.IsLetter(r)
+		})
+		if len(word) < 3 {
+			continue
+		}
+		out = append(out, capitalise(strings.ToLower(word)))
+	}
+	return strings.Join(out, " ")
+}
+
+func capitalise(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// section is a heading followed by some paragraphs and sometimes a list.
+type section struct {
+	Heading    string
+	Paragraphs []string
+	Items      []string
+}
+
+// Sections generates between two and four sections of a page body.
+func (g *textGenerator) Sections(rng *rand.Rand) []section {
+	sections := make([]section, 2+rng.Intn(3))
+	for i := range sections {
+		s := &sections[i]
+		s.Heading = g.Phrase(rng, 2, 5)
+
+		paragraphs := 1 + rng.Intn(3)
+		for j := 0; j < paragraphs; j++ {
+			s.Paragraphs = append(s.Paragraphs, g.Paragraph(rng))
+		}
+
+		if rng.Intn(2) == 0 {
+			items := 3 + rng.Intn(4)
+			for j := 0; j < items; j++ {
+				s.Items = append(s.Items, g.Phrase(rng, 1, 4))
+			}
+		}
+	}
+	return sections
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTextGeneratorDeterministic(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		a := prose.Sections(rand.New(rand.NewSource(seed)))
+		b := prose.Sections(rand.New(rand.NewSource(seed)))
+		if !reflect.DeepEqual(a, b) {
+			t.Fatalf("seed %d generated two different pages", seed)
+		}
+	}
+
+	a := prose.Paragraph(rand.New(rand.NewSource(1)))
+	b := prose.Paragraph(rand.New(rand.NewSource(2)))
+	if a == b {
+		t.Fatal("different seeds generated the same paragraph")
+	}
+}
+
+func TestTextGeneratorNotACopy(t *testing.T) {
+	copied := 0
+	const sentences = 2000
+	for seed := int64(0); seed < sentences; seed++ {
+		sentence := prose.Sentence(rand.New(rand.NewSource(seed)))
+		if prose.sources[sentence] {
+			copied++
+		}
+		if strings.Count(sentence, " ") < markovOrder-1 {
+			t.Fatalf("seed %d generated %q", seed, sentence)
+		}
+	}
+	if copied > 0 {
+		t.Fatalf("%d of %d sentences were copied from the corpus", copied, sentences)
+	}
+
+	// a paragraph is never a run of the corpus either.
+	for seed := int64(0); seed < 200; seed++ {
+		paragraph := prose.Paragraph(rand.New(rand.NewSource(seed)))
+		if strings.Contains(corpus, paragraph) {
+			t.Fatalf("seed %d generated a paragraph found in the corpus: %s", seed, paragraph)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// trap serves the generated pages and images and records every hit on them.
+type trap struct {
+	cfg     Config
+	stats   StatsRecorder
+	events  *EventStore
+	metrics *metrics
+	// tarpit is nil when slow responses are disabled.
+	tarpit     *tarpit
+	classifier *classifier
+	// verifier is nil when DNS verification is disabled.
+	verifier *verifier
+	ips      *clientIPResolver
+	// asn is nil when no IP to ASN database is configured.
+	asn       *asnDB
+	sessions  *sessionTracker
+	robots    *robots
+	templates *templateRegistry
+}
+
+// Kinds of content a hit was served.
+const (
+	kindPage    = "page"
+	kindImage   = "image"
+	kindEndless = "endless"
+	kindRobots  = "robots"
+	kindSitemap = "sitemap"
+	kindFeed    = "feed"
+)
+
+// recordHit logs the request to the event log, the crawler stats and the
+// metrics.
+func (t *trap) recordHit(r *http.Request, kind string) {
+	t.appendEvent(t.hit(r, kind))
+}
+
+// hit counts the request in the crawler stats and metrics and returns its
+// event, for callers that fill in more detail before appending it.
+func (t *trap) hit(r *http.Request, kind string) Event {
+	clientIP := t.ips.clientIP(r)
+
+	slog.Debug("Request received",
+		"host", r.Host,
+		"path", r.URL.Path,
+		"kind", kind,
+		"user_agent", r.UserAgent(),
+		"remote_addr", r.RemoteAddr,
+		"client_ip", clientIP,
+		"x_forwarded_for", r.Header.Get("X-Forwarded-For"),
+	)
+
+	now := time.Now()
+	family := crawlerFamily(r.UserAgent())
+	isCrawler := family.Name != noFamily
+	depth := pageDepth(r.Host, r.URL.Path, t.cfg.Domain)
+	verdict := t.classifier.observe(clientIP+"\x00"+r.UserAgent(), r, kind, depth, now)
+
+	t.metrics.recordRequest(family.Name)
+
+	var verification string
+	if isCrawler && t.verifier != nil {
+		verification = t.verifier.verify(r.Context(), clientIP, family.Name)
+		t.metrics.recordVerification(verification)
+	}
+
+	if isCrawler {
+		slog.Info("crawler detected", "client_ip", clientIP, "user_agent", r.UserAgent(), "family", family.Name, "verification", verification)
+		t.stats.Record(r.UserAgent())
+	} else if verdict.Bot {
+		slog.Info("crawler detected by behaviour", "client_ip", clientIP, "user_agent", r.UserAgent(), "reasons", verdict.Reasons)
+		t.metrics.behaviorBots.Add(1)
+	}
+
+	// images and robots.txt are not hops through the link graph so only
+	// pages, sitemaps and feeds are followed through the session.
+	var sessionID, parent string
+	if kind == kindPage || kind == kindEndless || kind == kindSitemap || kind == kindFeed {
+		sessionID, parent = t.sessions.observe(clientIP+"\x00"+r.UserAgent(), requestPage(r.Host, r.URL.Path), r.Referer(), now)
+	}
+
+	var robotsViolation string
+	if kind != kindRobots {
+		robotsViolation = t.robots.check(clientIP+"\x00"+r.UserAgent(), r.Host, r.URL.Path, now)
+		if robotsViolation != "" {
+			t.metrics.recordRobotsViolation(robotsViolation)
+		}
+	}
+
+	var asn uint32
+	var asOrg string
+	if t.asn != nil {
+		asn, asOrg = t.asn.lookup(clientIP)
+	}
+
+	return Event{
+		Time:          now,
+		Kind:          kind,
+		Host:          r.Host,
+		Path:          r.URL.Path,
+		RemoteAddr:    r.RemoteAddr,
+		ClientIP:      clientIP,
+		XForwardedFor: r.Header.Get("X-Forwarded-For"),
+		ASN:           asn,
+		ASOrg:         asOrg,
+		UserAgent:     r.UserAgent(),
+		Referer:       r.Referer(),
+		Session:       sessionID,
+		Parent:        parent,
+		Depth:         depth,
+		Crawler:       isCrawler,
+		Behavior:      verdict,
+		Verification:  verification,
+		Robots:        robotsViolation,
+	}
+}
+
+func (t *trap) appendEvent(ev Event) {
+	if err := t.events.Append(ev); err != nil {
+		slog.Error("failed to append event", "error", err)
+	}
+}
+
+// emitLinks tells the session tracker which links the client of ev was
+// served on its page.
+func (t *trap) emitLinks(ev Event, links []link) {
+	t.sessions.emit(ev.ClientIP+"\x00"+ev.UserAgent, requestPage(ev.Host, ev.Path), links)
+}
+
+func (t *trap) servePage(w http.ResponseWriter, r *http.Request) {
+	ev := t.hit(r, kindPage)
+	canary := newCanary(t.cfg.Secret, ev.Session, requestPage(ev.Host, ev.Path), t.cfg.Domain)
+	ev.Canaries = canary.Strings()
+	t.appendEvent(ev)
+
+	rng := pageRand(t.cfg.Secret, r.Host, r.URL.Path)
+
+	p := page{
+		Image:    fmt.Sprintf("/img/%x.png", rng.Uint64()),
+		Title:    capitalise(prose.Phrase(rng, 3, 7)),
+		Name:     pageName(r.Host, r.URL.Path),
+		Sections: prose.Sections(rng),
+		Canary:   canary,
+		Feeds:    t.cfg.Feed.Enabled,
+	}
+
+	p.Links = generateLinks(rng, t.cfg.LinkMode, t.cfg.Domain, t.cfg.LinkCount)
+	if t.cfg.Endless.Enabled {
+		p.Links = append(p.Links, endlessLink(rng, t.cfg.Endless.Path))
+	}
+	t.emitLinks(ev, p.Links)
+	p.Hidden = hiddenLink(rng)
+
+	name, tmpl := t.templates.forHost(t.cfg.Secret, r.Host)
+	var content bytes.Buffer
+	if err := tmpl.Execute(&content, p); err != nil {
+		slog.Error("servePage: failed to render", "template", name, "error", err)
+		http.Error(w, "Could not render page.", http.StatusInternalServerError)
+		return
+	}
+
+	setTrackingCookie(w, r)
+
+	w.Header().Set("Keep-Alive", "timeout=5, max=1000")
+	w.Header().Set("Connection", "Keep-Alive")
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set("Server", servers[rng.Intn(len(servers))])
+
+	w.Header().Set("Content-Type", "text/html")
+	n := t.write(w, r, content.Bytes())
+	t.metrics.recordPage(n)
+}
+
+// write sends content, through the tarpit when it is enabled and the
+// client still has budget left.
+func (t *trap) write(w http.ResponseWriter, r *http.Request, content []byte) int {
+	if t.tarpit != nil {
+		if release, ok := t.tarpit.acquire(t.ips.clientIP(r)); ok {
+			defer release()
+
+			t.metrics.tarpitActive.Add(1)
+			defer t.metrics.tarpitActive.Add(-1)
+
+			n, _ := t.tarpit.write(r.Context(), w, content)
+			return n
+		}
+	}
+
+	n, _ := w.Write(content)
+	return n
+}
+
+// setTrackingCookie gives the client a session cookie unless it already
+// sent one back, so the classifier can tell who keeps cookies.
+func setTrackingCookie(w http.ResponseWriter, r *http.Request) {
+	if _, err := r.Cookie(trackingCookie); err == nil {
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     trackingCookie,
+		Value:    strconv.FormatUint(rand.Uint64(), 36),
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// pageDepth is how far into the generated link graph a page sits: one level
+// for every generated subdomain label in front of the domain and one for
+// every path segment.
+func pageDepth(host, path, domain string) int {
+	depth := 0
+
+	if sub, ok := strings.CutSuffix(host, "."+domain); ok && sub != "" {
+		depth += strings.Count(sub, ".") + 1
+	}
+
+	for _, part := range strings.Split(path, "/") {
+		if part != "" {
+			depth++
+		}
+	}
+
+	return depth
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"html/template"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newTestTrap returns a trap over cfg with its event log in a temporary
+// directory.
+func newTestTrap(t *testing.T, cfg Config) *trap {
+	t.Helper()
+
+	events, err := OpenEventStore(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { events.Close() })
+
+	ips, err := newClientIPResolver(cfg.TrustedProxies, cfg.ClientIPHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	templates, err := loadTemplates(cfg.TemplateDir, cfg.Templates)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &trap{
+		cfg:        cfg,
+		stats:      newMemoryStats(),
+		events:     events,
+		metrics:    newMetrics(),
+		classifier: newClassifier(),
+		ips:        ips,
+		sessions:   newSessionTracker(cfg.SessionIdle),
+		robots:     newRobots(cfg.Robots, cfg.Sitemap.Enabled),
+		templates:  templates,
+	}
+}
+
+// fetch serves one request for host and path from the client at remote
+// with user agent ua and returns the page.
+func fetch(t *testing.T, handler http.HandlerFunc, host, path, remote, ua string) string {
+	t.Helper()
+
+	r := httptest.NewRequest("GET", path, nil)
+	r.Host = host
+	r.RemoteAddr = remote
+	r.Header.Set("User-Agent", ua)
+
+	w := httptest.NewRecorder()
+	handler(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET %s%s: status %d", host, path, w.Code)
+	}
+
+	body, _ := io.ReadAll(w.Body)
+	return string(body)
+}
+
+func TestServePageCanaries(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Secret = "test"
+	tr := newTestTrap(t, cfg)
+
+	const ua = "Googlebot/2.1 (+http://www.google.com/bot.html)"
+	first := fetch(t, tr.servePage, "a.test", "/p/one.html", "203.0.113.1:1000", ua)
+	again := fetch(t, tr.servePage, "a.test", "/p/one.html", "203.0.113.1:1000", ua)
+	if first != again {
+		t.Fatal("fetching a page again in the same session gave different HTML")
+	}
+
+	other := fetch(t, tr.servePage, "a.test", "/p/one.html", "203.0.113.2:1000", ua)
+	if other == first {
+		t.Fatal("another client's session was served the same canaries")
+	}
+
+	var canaries [][]string
+	if err := tr.events.Scan(func(ev Event) error {
+		canaries = append(canaries, ev.Canaries)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(canaries) != 3 || len(canaries[0]) != 3 {
+		t.Fatalf("events carry canaries %v", canaries)
+	}
+	if canaries[0][1] != canaries[1][1] || canaries[0][1] == canaries[2][1] {
+		t.Fatalf("canary emails %s, %s and %s, want the first two equal and the third different",
+			canaries[0][1], canaries[1][1], canaries[2][1])
+	}
+}
+
+func TestServePageEscapes(t *testing.T) {
+	registry, err := loadTemplates("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const payload = `<script>alert("x&y")</script>'`
+	requests := []struct{ host, path string }{
+		// the name comes from the first label of the host on the front
+		// page and from the path everywhere else.
+		{payload + ".evil.test", "/"},
+		{"evil.test", "/p/" + payload + ".html"},
+		{payload + ".evil.test", "/p/" + payload + "-" + payload + ".html"},
+	}
+
+	for _, name := range registry.names {
+		t.Run(name, func(t *testing.T) {
+			cfg := defaultConfig()
+			cfg.Templates = []string{name}
+			tr := newTestTrap(t, cfg)
+
+			for _, req := range requests {
+				page := fetch(t, tr.servePage, req.host, req.path, "203.0.113.1:1000", "curl/8.0")
+
+				if strings.Contains(strings.ToLower(page), "<script") {
+					t.Errorf("%s%s: script tag rendered unescaped", req.host, req.path)
+				}
+				if strings.Contains(page, `"x&y"`) || strings.Contains(page, `"X&y"`) {
+					t.Errorf("%s%s: quotes or ampersand rendered unescaped", req.host, req.path)
+				}
+
+				escaped := template.HTMLEscapeString(pageName(req.host, req.path))
+				if !strings.Contains(page, escaped) {
+					t.Errorf("%s%s: escaped name %s not on the page", req.host, req.path, escaped)
+				}
+			}
+		})
+	}
+}
+
+func TestServePageLinkCount(t *testing.T) {
+	registry, err := loadTemplates("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	links := func(name string, count int) int {
+		cfg := defaultConfig()
+		cfg.Templates = []string{name}
+		cfg.LinkCount = count
+		tr := newTestTrap(t, cfg)
+
+		page := fetch(t, tr.servePage, "a.test", "/p/one.html", "203.0.113.1:1000", "curl/8.0")
+		return strings.Count(page, "<a href=")
+	}
+
+	for _, name := range registry.names {
+		t.Run(name, func(t *testing.T) {
+			few, many := links(name, 3), links(name, 42)
+			if many-few != 39 {
+				t.Fatalf("%d links with a link count of 3 and %d with 42, want 39 more", few, many)
+			}
+		})
+	}
+}
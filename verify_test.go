@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeResolver answers from fixed tables. Names or addresses missing from
+// them are NXDOMAIN unless an error is set for them.
+type fakeResolver struct {
+	ptr  map[string][]string
+	a    map[string][]string
+	errs map[string]error
+	// hang makes every lookup wait for its context to end.
+	hang bool
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (f *fakeResolver) answer(ctx context.Context, name string) error {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+
+	if f.hang {
+		<-ctx.Done()
+		return &net.DNSError{Err: ctx.Err().Error(), Name: name, IsTimeout: true}
+	}
+	return f.errs[name]
+}
+
+func (f *fakeResolver) LookupAddr(ctx context.Context, addr string) ([]string, error) {
+	if err := f.answer(ctx, addr); err != nil {
+		return nil, err
+	}
+	hosts, ok := f.ptr[addr]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: addr, IsNotFound: true}
+	}
+	return hosts, nil
+}
+
+func (f *fakeResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	if err := f.answer(ctx, host); err != nil {
+		return nil, err
+	}
+	ips, ok := f.a[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	var out []net.IPAddr
+	for _, ip := range ips {
+		out = append(out, net.IPAddr{IP: net.ParseIP(ip)})
+	}
+	return out, nil
+}
+
+func (f *fakeResolver) lookups() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func testVerifyConfig() VerifyConfig {
+	return VerifyConfig{Enabled: true, Timeout: 50 * time.Millisecond, CacheTTL: time.Hour}
+}
+
+func TestVerify(t *testing.T) {
+	servfail := &net.DNSError{Err: "server misbehaving", Name: "66.249.66.9", IsTemporary: true}
+
+	resolver := &fakeResolver{
+		ptr: map[string][]string{
+			"66.249.66.1":   {"crawl-66-249-66-1.googlebot.com."},
+			"66.249.66.2":   {"crawl-66-249-66-2.evilgooglebot.com."},
+			"66.249.66.3":   {"crawl-66-249-66-3.googlebot.com."},
+			"66.249.66.4":   {"crawl-66-249-66-4.googlebot.com."},
+			"2001:4860::1":  {"crawl-ipv6.googlebot.com."},
+			"157.55.39.1":   {"msnbot-157-55-39-1.search.msn.com."},
+			"203.0.113.10":  {"host.example.net.", "crawl-66-249-66-1.googlebot.com."},
+			"203.0.113.11":  {"notgooglebot.com."},
+			"198.51.100.20": {"crawl.googlebot.com."},
+		},
+		a: map[string][]string{
+			"crawl-66-249-66-1.googlebot.com":     {"66.249.66.1"},
+			"crawl-66-249-66-2.evilgooglebot.com": {"66.249.66.2"},
+			"crawl-66-249-66-3.googlebot.com":     {"66.249.66.99"},
+			"crawl-ipv6.googlebot.com":            {"2001:4860::1"},
+			"msnbot-157-55-39-1.search.msn.com":   {"157.55.39.1"},
+		},
+		errs: map[string]error{
+			"66.249.66.9":         servfail,
+			"crawl.googlebot.com": &net.DNSError{Err: "server misbehaving", Name: "crawl.googlebot.com", IsTemporary: true},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		ip     string
+		family string
+		want   string
+	}{
+		{"verified", "66.249.66.1", "Googlebot", verifyVerified},
+		{"verified ipv6", "2001:4860::1", "Googlebot-Image", verifyVerified},
+		{"verified bing", "157.55.39.1", "bingbot", verifyVerified},
+		{"wrong suffix", "66.249.66.2", "Googlebot", verifySpoofed},
+		{"suffix without a dot", "203.0.113.11", "Googlebot", verifySpoofed},
+		{"right suffix for another family", "157.55.39.1", "Googlebot", verifySpoofed},
+		{"forward lookup mismatch", "66.249.66.3", "Googlebot", verifySpoofed},
+		{"forward lookup NXDOMAIN", "66.249.66.4", "Googlebot", verifySpoofed},
+		{"reverse lookup NXDOMAIN", "192.0.2.1", "Googlebot", verifySpoofed},
+		{"name of another address", "203.0.113.10", "Googlebot", verifySpoofed},
+		{"reverse lookup SERVFAIL", "66.249.66.9", "Googlebot", verifyUnknown},
+		{"forward lookup SERVFAIL", "198.51.100.20", "Googlebot", verifyUnknown},
+		{"family without hostnames", "66.249.66.1", "AhrefsBot", verifyUnknown},
+		{"not an IP", "nonsense", "Googlebot", verifyUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newVerifier(testVerifyConfig(), resolver)
+			if got := v.verify(context.Background(), tt.ip, tt.family); got != tt.want {
+				t.Fatalf("verify(%s, %s) = %s, want %s", tt.ip, tt.family, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyTimeout(t *testing.T) {
+	v := newVerifier(testVerifyConfig(), &fakeResolver{hang: true})
+
+	start := time.Now()
+	if got := v.verify(context.Background(), "66.249.66.1", "Googlebot"); got != verifyUnknown {
+		t.Fatalf("verify = %s, want %s", got, verifyUnknown)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("verify took %s, the timeout is %s", elapsed, testVerifyConfig().Timeout)
+	}
+}
+
+func TestVerifyCache(t *testing.T) {
+	resolver := &fakeResolver{
+		ptr: map[string][]string{"66.249.66.1": {"crawl-66-249-66-1.googlebot.com"}},
+		a:   map[string][]string{"crawl-66-249-66-1.googlebot.com": {"66.249.66.1"}},
+	}
+	cfg := testVerifyConfig()
+	cfg.CacheTTL = 100 * time.Millisecond
+	v := newVerifier(cfg, resolver)
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if got := v.verify(ctx, "66.249.66.1", "Googlebot"); got != verifyVerified {
+			t.Fatalf("verify = %s, want %s", got, verifyVerified)
+		}
+	}
+	if n := resolver.lookups(); n != 2 {
+		t.Fatalf("%d lookups for three cached checks, want 2", n)
+	}
+
+	time.Sleep(cfg.CacheTTL + 20*time.Millisecond)
+
+	if got := v.verify(ctx, "66.249.66.1", "Googlebot"); got != verifyVerified {
+		t.Fatalf("verify after expiry = %s, want %s", got, verifyVerified)
+	}
+	if n := resolver.lookups(); n != 4 {
+		t.Fatalf("%d lookups after the cache expired, want 4", n)
+	}
+}
+
+func TestLookupFailure(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{&net.DNSError{Err: "no such host", IsNotFound: true}, verifySpoofed},
+		{&net.DNSError{Err: "i/o timeout", IsTimeout: true}, verifyUnknown},
+		{&net.DNSError{Err: "server misbehaving", IsTemporary: true}, verifyUnknown},
+		{errors.New("connection refused"), verifyUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := lookupFailure(tt.err); got != tt.want {
+			t.Errorf("lookupFailure(%v) = %s, want %s", tt.err, got, tt.want)
+		}
+	}
+}